@@ -5,12 +5,30 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	// Internal
 	"casino/libs/store"
 	"casino/services/blackjack"
 )
 
+// A keyboard command for an action a player can take on their turn.
+type command struct {
+	key    string
+	label  string
+	name   blackjack.ActionName
+	action blackjack.Action
+}
+
+var commands = []command{
+	{"h", "(h)it", blackjack.ActionHit, blackjack.Hit{}},
+	{"s", "(s)tand", blackjack.ActionStand, blackjack.Stand{}},
+	{"d", "(d)ouble", blackjack.ActionDouble, blackjack.Double{}},
+	{"sp", "(sp)lit", blackjack.ActionSplit, blackjack.Split{}},
+	{"sur", "(sur)render", blackjack.ActionSurrender, blackjack.Surrender{}},
+}
+
 // TODO: finish up game loop - hands are cleared correctly, but after the first round
 // something goes wrong with the bets
 func main() {
@@ -23,11 +41,21 @@ func main() {
 	p2 := blackjack.NewPlayer("2", "Shane")
 	p3 := blackjack.NewPlayer("3", "Jason")
 
-	// 3. Initialize game, players join
-	g := blackjack.NewGame(st)
-	g.Seat1 = p1
-	g.Seat2 = p2
-	g.Seat3 = p3
+	// 3. Initialize game from the preset named on the command line, players join
+	preset := blackjack.DefaultPreset
+	if len(os.Args) > 1 {
+		preset = os.Args[1]
+	}
+	g, err := blackjack.NewGameFromPreset(st, preset)
+	if err != nil {
+		fmt.Println("error:", err, "- presets:", strings.Join(blackjack.Presets(), ", "))
+		return
+	}
+	for _, p := range []*blackjack.Player{p1, p2, p3} {
+		if _, err := g.JoinTable(p); err != nil {
+			fmt.Println("error:", err)
+		}
+	}
 
 	// 4. Open table and shuffle cards
 	g.Shuffle()
@@ -39,12 +67,17 @@ func main() {
 		// 7. Deal cards in two passes
 		g.DealCards()
 		blackjack.PrintDealerHand(g)
+		// Shared-hand tables play every hand together at each decision point.
+		if def, _ := blackjack.LookupVariant(g.Config.Variant); def.SharedHand {
+			if !playSharedTurns(g) {
+				return
+			}
+		}
 		// 8. Add initial player turns to queue
 		if g.State == blackjack.StatePlayerTurn {
-			g.DoForEachPlayer(func(p *blackjack.Player) {
-				h := p.Hands[0]
-				blackjack.PrintPlayerHand(p, h)
-				turn := blackjack.NewTurn(p, h)
+			g.DoForEachBoxInPlay(func(b *blackjack.Box) {
+				blackjack.PrintPlayerHand(b.Player, b.Hand)
+				turn := blackjack.NewTurn(b.Player, b.Hand)
 				g.Enqueue(*turn)
 			})
 		}
@@ -69,39 +102,33 @@ func main() {
 				continue
 			}
 
-			blackjack.PrintPlayerHand(p, h)
-			fmt.Printf("\n%s, Enter action (h)it/(s)tand/(d)ouble/(sp)lit/(sur)render/(q)uit: ", p.Name)
-			scanner := bufio.NewScanner(os.Stdin)
-			if !scanner.Scan() {
-				if err := scanner.Err(); err != nil {
-					fmt.Println("Error reading input:", err)
-				} else {
-					fmt.Println("stdin closed (EOF)")
-				}
-				return
+			if h.Status == blackjack.Surrendered {
+				fmt.Println("Player surrendered; skipping")
+				g.AdvanceTurn()
+				continue
+			}
+
+			if h.Status == blackjack.Settled {
+				fmt.Println("Player took even money; skipping")
+				g.AdvanceTurn()
+				continue
 			}
-			cmd := scanner.Text()
-			_ = cmd
-			var err error
-			endTurn := false
-			switch cmd {
-			case "h":
-				endTurn, err = blackjack.ApplyAction(g, p.ID, blackjack.Hit{}, h)
-			case "s":
-				endTurn, err = blackjack.ApplyAction(g, p.ID, blackjack.Stand{}, h)
-			case "d":
-				endTurn, err = blackjack.ApplyAction(g, p.ID, blackjack.Double{}, h)
-			case "sp":
-				endTurn, err = blackjack.ApplyAction(g, p.ID, blackjack.Split{}, h)
-			case "sur":
-				endTurn, err = blackjack.ApplyAction(g, p.ID, blackjack.Surrender{}, h)
-			case "q":
-				fmt.Println("Quitting game.")
+
+			legal := blackjack.LegalActions(g, p.ID, h.Index)
+			if len(legal.Permitted()) == 0 {
+				fmt.Println("No actions available; skipping")
+				g.AdvanceTurn()
+				continue
+			}
+
+			action, ok := promptAction(p, h, legal)
+			if !ok {
 				return
-			default:
-				fmt.Println("Unknown command:", cmd)
+			}
+			if action == nil {
 				continue
 			}
+			endTurn, err := blackjack.ApplyAction(g, p.ID, action, h.Index)
 			if err != nil {
 				fmt.Println("error:", err)
 			}
@@ -126,3 +153,80 @@ func main() {
 		}
 	}
 }
+
+// Prompts a player for one of the legal actions on a hand.  Returns a nil
+// action for input that is not a legal command, and false once the player
+// quits or input ends.
+func promptAction(p *blackjack.Player, h *blackjack.Hand, legal blackjack.ActionSet) (blackjack.Action, bool) {
+	var options []string
+	for _, c := range commands {
+		if legal.Allowed(c.name) {
+			options = append(options, c.label)
+		}
+	}
+
+	blackjack.PrintPlayerHand(p, h)
+	fmt.Printf("\n%s, Enter action %s/(q)uit: ", p.Name, strings.Join(options, "/"))
+	scanner := bufio.NewScanner(os.Stdin)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			fmt.Println("Error reading input:", err)
+		} else {
+			fmt.Println("stdin closed (EOF)")
+		}
+		return nil, false
+	}
+	cmd := scanner.Text()
+	if cmd == "q" {
+		fmt.Println("Quitting game.")
+		return nil, false
+	}
+	for _, c := range commands {
+		if c.key != cmd {
+			continue
+		}
+		if err := legal.Reason(c.name); err != nil {
+			fmt.Println("Action not available:", err)
+			return nil, true
+		}
+		return c.action, true
+	}
+	if !slices.ContainsFunc(commands, func(c command) bool { return c.key == cmd }) {
+		fmt.Println("Unknown command:", cmd)
+	}
+	return nil, true
+}
+
+// Collects every player's decision at each decision point of a shared-hand
+// round, then deals them out together.  Returns false once a player quits.
+func playSharedTurns(g *blackjack.Game) bool {
+	for g.State == blackjack.StatePlayerTurn {
+		for _, t := range g.PendingHands() {
+			p, h := t.Player, t.Hand
+			legal := blackjack.LegalActions(g, p.ID, h.Index)
+			for {
+				action, ok := promptAction(p, h, legal)
+				if !ok {
+					return false
+				}
+				if action == nil {
+					continue
+				}
+				if err := g.Decide(p.ID, h.Index, action); err != nil {
+					fmt.Println("error:", err)
+					continue
+				}
+				break
+			}
+		}
+		g.ResolveDecisions()
+	}
+	g.DoForEachBoxInPlay(func(b *blackjack.Box) {
+		for _, h := range b.Player.Hands {
+			if h.Seat == b.Seat {
+				blackjack.PrintPlayerHand(b.Player, h)
+			}
+		}
+	})
+	return true
+}
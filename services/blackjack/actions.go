@@ -3,6 +3,7 @@ package blackjack
 import (
 	// Standard libs
 	"fmt"
+	"reflect"
 )
 
 // Action is the interface every game command implements.
@@ -10,18 +11,165 @@ type Action interface {
 	Execute(g *Game, p *Player, h *Hand) (endTurn bool, err error)
 }
 
-func ApplyAction(g *Game, pID string, action Action, h *Hand) (bool, error) {
-	var p *Player
-	switch pID {
-	case g.Seat1.ID:
-		p = g.Seat1
-	case g.Seat2.ID:
-		p = g.Seat2
-	case g.Seat3.ID:
-		p = g.Seat3
+// Validator is implemented by actions that can be checked without being executed.
+type Validator interface {
+	Validate(g *Game, p *Player, h *Hand) error
+}
+
+// Implemented by actions that may be taken without a hand, passing NoHand.
+type handless interface {
+	handless()
+}
+
+/*
+Dispatches an action for a player's hand, identified by its index.
+While players are taking turns only the hand at the head of the turn queue
+may act; other phases, such as insurance, apply to any of the player's hands.
+Shared-hand tables have no turn queue, and while players are taking turns
+their actions must go through Decide and ResolveDecisions instead.
+Pass NoHand for actions taken before cards are dealt, such as side bets;
+every other action needs a hand.
+*/
+func ApplyAction(g *Game, pID string, action Action, idx HandIndex) (bool, error) {
+	if g.State == StatePlayerTurn && g.variant().SharedHand {
+		return false, fmt.Errorf("%w: shared hands play through Decide and ResolveDecisions", ErrWrongState)
 	}
-	if p == nil {
-		return false, fmt.Errorf("unknown player %s", pID)
+	p, h, err := g.resolveHand(pID, idx)
+	if err != nil {
+		return false, err
+	}
+	if h == nil {
+		if _, ok := action.(handless); !ok {
+			return false, fmt.Errorf("%w %d for player %s", ErrUnknownHand, idx, pID)
+		}
 	}
 	return action.Execute(g, p, h)
 }
+
+// Looks up a player's hand and checks it is theirs to act on.
+func (g *Game) resolveHand(pID string, idx HandIndex) (*Player, *Hand, error) {
+	p := g.FindPlayer(pID)
+	if p == nil {
+		return nil, nil, fmt.Errorf("%w %s", ErrUnknownPlayer, pID)
+	}
+	if idx == NoHand {
+		return p, nil, nil
+	}
+	h := p.HandAt(idx)
+	if h == nil {
+		return p, nil, fmt.Errorf("%w %d for player %s", ErrUnknownHand, idx, pID)
+	}
+	if g.State == StatePlayerTurn && !g.variant().SharedHand {
+		turn, ok := g.Peek()
+		if !ok || turn.Player == nil || turn.Hand == nil {
+			return p, h, &TurnError{PlayerID: pID, Hand: idx}
+		}
+		if turn.Player.ID != pID || turn.Hand != h {
+			return p, h, &TurnError{
+				PlayerID:     pID,
+				Hand:         idx,
+				TurnPlayerID: turn.Player.ID,
+				TurnHand:     turn.Hand.Index,
+			}
+		}
+	}
+	return p, h, nil
+}
+
+//	----- Legal Actions -----
+
+type ActionName string
+
+const (
+	ActionHit       ActionName = "HIT"
+	ActionStand     ActionName = "STAND"
+	ActionDouble    ActionName = "DOUBLE"
+	ActionSplit     ActionName = "SPLIT"
+	ActionSurrender ActionName = "SURRENDER"
+	ActionInsurance ActionName = "INSURANCE"
+	ActionEvenMoney ActionName = "EVEN_MONEY"
+	ActionSwitch    ActionName = "SWITCH"
+)
+
+// An action and, when it is not permitted, the reason why.
+type LegalAction struct {
+	Name ActionName
+	Err  error // nil when the action is permitted
+}
+
+type ActionSet []LegalAction
+
+// The actions checked for a hand, in the order a client would present them.
+// Double and insurance are checked at their full amounts.
+var playerActions = []struct {
+	name   ActionName
+	action Validator
+}{
+	{ActionHit, Hit{}},
+	{ActionStand, Stand{}},
+	{ActionDouble, Double{}},
+	{ActionSplit, Split{}},
+	{ActionSurrender, Surrender{}},
+	{ActionInsurance, Insurance{}},
+	{ActionEvenMoney, EvenMoney{}},
+	{ActionSwitch, Switch{}},
+}
+
+// Returns the name of a player action.
+func actionName(action Action) (ActionName, bool) {
+	for _, a := range playerActions {
+		if reflect.TypeOf(a.action) == reflect.TypeOf(action) {
+			return a.name, true
+		}
+	}
+	return "", false
+}
+
+// Returns every player action with whether it is permitted for the hand,
+// based on the turn, game state, table rules and variant, the player's wallet and the hand.
+func LegalActions(g *Game, playerID string, idx HandIndex) ActionSet {
+	set := make(ActionSet, 0, len(playerActions))
+	p, h, err := g.resolveHand(playerID, idx)
+	if err == nil && h == nil {
+		err = fmt.Errorf("%w %d for player %s", ErrUnknownHand, idx, playerID)
+	}
+	for _, a := range playerActions {
+		actionErr := err
+		if actionErr == nil {
+			actionErr = g.validate(a.name, a.action, p, h)
+		}
+		set = append(set, LegalAction{Name: a.name, Err: actionErr})
+	}
+	return set
+}
+
+// Returns true if the named action is permitted.
+func (s ActionSet) Allowed(name ActionName) bool {
+	for _, a := range s {
+		if a.Name == name {
+			return a.Err == nil
+		}
+	}
+	return false
+}
+
+// Returns the names of the permitted actions.
+func (s ActionSet) Permitted() []ActionName {
+	var names []ActionName
+	for _, a := range s {
+		if a.Err == nil {
+			names = append(names, a.Name)
+		}
+	}
+	return names
+}
+
+// Returns the reason an action is not permitted, or nil if it is.
+func (s ActionSet) Reason(name ActionName) error {
+	for _, a := range s {
+		if a.Name == name {
+			return a.Err
+		}
+	}
+	return fmt.Errorf("%w %s", ErrUnknownAction, name)
+}
@@ -0,0 +1,115 @@
+package blackjack
+
+import (
+	// Standard libs
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestLegalActions(t *testing.T) {
+	all := []ActionName{ActionHit, ActionStand, ActionDouble, ActionSplit, ActionSurrender}
+	tests := []struct {
+		name  string
+		setup func(g *Game, p *Player)
+		want  []ActionName
+	}{
+		{"first action", func(g *Game, p *Player) {}, all},
+		{"after a hit", func(g *Game, p *Player) {
+			if _, err := (Hit{}).Execute(g, p, p.Hands[0]); err != nil {
+				t.Fatal(err)
+			}
+		}, []ActionName{ActionHit, ActionStand}},
+		{"short of funds", func(g *Game, p *Player) { p.LocalWallet = 5 }, []ActionName{ActionHit, ActionStand, ActionSurrender}},
+		{"insurance round", func(g *Game, p *Player) {
+			g.Dealer.Hand.Cards[0] = card("A")
+			g.State = StateInsuranceTurn
+		}, []ActionName{ActionInsurance}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 8,8 against a dealer 10/7.
+			g, p := dealRound(t, DefaultPreset, 10, []string{"8", "10", "8", "7", "2"})
+			tt.setup(g, p)
+			got := LegalActions(g, p.ID, 0).Permitted()
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("permitted = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLegalActionsReasons(t *testing.T) {
+	g, p := dealRound(t, DefaultPreset, 10, []string{"8", "10", "8", "7"})
+	set := LegalActions(g, p.ID, 0)
+	if set.Reason(ActionInsurance) == nil || set.Allowed(ActionInsurance) {
+		t.Fatal("insurance permitted against a ten")
+	}
+	if set.Reason("FOLD") == nil {
+		t.Fatal("unknown action has no reason")
+	}
+
+	for _, a := range LegalActions(g, p.ID, 1) {
+		if a.Err == nil {
+			t.Fatalf("%s permitted on a hand the player does not hold", a.Name)
+		}
+	}
+	if got := LegalActions(g, "nobody", 0).Permitted(); len(got) != 0 {
+		t.Fatalf("permitted for an unknown player: %v", got)
+	}
+}
+
+func TestApplyActionNoHand(t *testing.T) {
+	tests := []struct {
+		name   string
+		action Action
+	}{
+		{"hit", Hit{}},
+		{"stand", Stand{}},
+		{"double", Double{}},
+		{"split", Split{}},
+		{"surrender", Surrender{}},
+		{"insurance", Insurance{}},
+		{"even money", EvenMoney{}},
+		{"switch", Switch{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := dealRound(t, DefaultPreset, 10, []string{"10", "9", "8", "7"})
+			_, err := ApplyAction(g, p.ID, tt.action, NoHand)
+			if !errors.Is(err, ErrUnknownHand) {
+				t.Fatalf("err = %v, want %v", err, ErrUnknownHand)
+			}
+		})
+	}
+}
+
+func TestApplyActionTurnOrder(t *testing.T) {
+	g, p := openBoxes(t, []int{1, 2}, []string{"10", "6", "9", "8", "5", "7"})
+	for _, seat := range []int{1, 2} {
+		if err := g.PlaceBet(seat, 10); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dealBoxes(g)
+
+	_, err := ApplyAction(g, p.ID, Stand{}, 1)
+	var turnErr *TurnError
+	if !errors.As(err, &turnErr) || !errors.Is(err, ErrNotYourTurn) {
+		t.Fatalf("err = %v, want a %T", err, turnErr)
+	}
+	if turnErr.Hand != 1 || turnErr.TurnPlayerID != p.ID || turnErr.TurnHand != 0 {
+		t.Fatalf("turn error = %+v, want hand 1 acting on hand 0's turn", *turnErr)
+	}
+
+	if _, err := ApplyAction(g, p.ID, Stand{}, 0); err != nil {
+		t.Fatal(err)
+	}
+	g.AdvanceTurn()
+	if _, err := ApplyAction(g, p.ID, Stand{}, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ApplyAction(g, "nobody", Stand{}, 1); !errors.Is(err, ErrUnknownPlayer) {
+		t.Fatalf("err = %v, want %v", err, ErrUnknownPlayer)
+	}
+}
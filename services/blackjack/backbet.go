@@ -0,0 +1,183 @@
+package blackjack
+
+import (
+	// Standard libs
+	"fmt"
+	// Internal
+	"casino/libs/store"
+)
+
+//	----- Bet Behind -----
+
+/*
+A participant who is not seated may wager behind a seated player's box.
+The back bet rides the box player's decisions: it wins, loses or pushes
+with the box's hands.  The policy decides whether the back bettor matches
+the box player's splits and doubles; when not followed, the back bet stays
+on the first hand at its original stake.
+*/
+
+type BackBetPolicy struct {
+	FollowSplits  bool
+	FollowDoubles bool
+}
+
+type BackBet struct {
+	Bettor  *Player
+	Seat    int
+	Amount  int
+	Policy  BackBetPolicy
+	Stakes  map[HandIndex]int // Stake riding each of the box player's hands
+	Doubled map[HandIndex]int // Part of each stake added by following a double
+}
+
+// Places a wager behind the box at a seat.
+func (g *Game) PlaceBackBet(bettor *Player, seat int, amount int, policy BackBetPolicy) error {
+	if g.State != StateBetsOpen {
+		return &StateError{Action: "bet behind", State: g.State}
+	}
+	if bettor == nil {
+		return fmt.Errorf("%w: cannot bet behind with a nil player", ErrUnknownPlayer)
+	}
+	if g.FindPlayer(bettor.ID) != nil {
+		return fmt.Errorf("%w: player %s is seated and cannot bet behind", ErrNotAllowed, bettor.ID)
+	}
+	b := g.BoxAt(seat)
+	if b == nil {
+		return fmt.Errorf("%w: seat %d", ErrSeatEmpty, seat)
+	}
+	if err := checkAmount("back bet", amount, g.Config.MinWager, g.Config.MaxWager); err != nil {
+		return err
+	}
+	if err := checkFunds(bettor, amount); err != nil {
+		return err
+	}
+
+	if err := g.takeWager(bettor, amount); err != nil {
+		return err
+	}
+	b.Behind = append(b.Behind, &BackBet{
+		Bettor:  bettor,
+		Seat:    seat,
+		Amount:  amount,
+		Policy:  policy,
+		Stakes:  map[HandIndex]int{},
+		Doubled: map[HandIndex]int{},
+	})
+
+	g.Store.Append(store.Event{
+		Type: "BackBet",
+		Payload: map[string]any{
+			"PlayerID":      bettor.ID,
+			"BoxPlayerID":   b.Player.ID,
+			"Seat":          seat,
+			"Amount":        amount,
+			"FollowSplits":  policy.FollowSplits,
+			"FollowDoubles": policy.FollowDoubles,
+			"RoundID":       g.RoundId,
+		},
+	})
+	return nil
+}
+
+// Puts each back bet on the box's initial hand once cards are dealt.
+func (b *Box) placeBehind() {
+	for _, bb := range b.Behind {
+		bb.Stakes[b.Hand.Index] = bb.Amount
+	}
+}
+
+// Matches a split for back bettors who follow splits and can cover it.
+func (g *Game) followSplit(h *Hand, splitHand *Hand) {
+	b := g.BoxAt(h.Seat)
+	if b == nil {
+		return
+	}
+	for _, bb := range b.Behind {
+		stake := bb.Stakes[h.Index]
+		if !bb.Policy.FollowSplits || stake == 0 {
+			continue
+		}
+		if err := g.takeWager(bb.Bettor, stake); err != nil {
+			continue
+		}
+		bb.Stakes[splitHand.Index] = stake
+		g.Store.Append(store.Event{
+			Type: "BackBetSplit",
+			Payload: map[string]any{
+				"PlayerID":  bb.Bettor.ID,
+				"Seat":      b.Seat,
+				"HandIndex": splitHand.Index,
+				"Amount":    stake,
+			},
+		})
+	}
+}
+
+// Matches a double, in proportion to the box player's, for back bettors who follow doubles.
+func (g *Game) followDouble(h *Hand, originalBet int, additional int) {
+	b := g.BoxAt(h.Seat)
+	if b == nil || originalBet <= 0 {
+		return
+	}
+	for _, bb := range b.Behind {
+		stake := bb.Stakes[h.Index]
+		extra := stake * additional / originalBet
+		if !bb.Policy.FollowDoubles || extra == 0 {
+			continue
+		}
+		if err := g.takeWager(bb.Bettor, extra); err != nil {
+			continue
+		}
+		bb.Stakes[h.Index] += extra
+		bb.Doubled[h.Index] += extra
+		g.Store.Append(store.Event{
+			Type: "BackBetDouble",
+			Payload: map[string]any{
+				"PlayerID":   bb.Bettor.ID,
+				"Seat":       b.Seat,
+				"HandIndex":  h.Index,
+				"Additional": extra,
+			},
+		})
+	}
+}
+
+// Pays each back bettor against the outcome of the hands they ride.
+func (g *Game) settleBackBets() {
+	g.DoForEachBox(func(b *Box) {
+		for _, bb := range b.Behind {
+			for _, h := range b.Player.Hands {
+				stake, ok := bb.Stakes[h.Index]
+				if !ok || h.Seat != b.Seat {
+					continue
+				}
+				var outcome Outcome
+				var payout int
+				if h.Status == Settled {
+					// Box player took even money.
+					outcome, payout = Win, stake*g.Config.Payout+stake
+				} else {
+					outcome, payout = g.settleStake(h, Stake{Amount: stake, Doubled: bb.Doubled[h.Index]})
+				}
+				bb.Bettor.LocalWallet += payout
+
+				g.Store.Append(store.Event{
+					Type: string(g.State),
+					Payload: map[string]any{
+						"BetType":     "BackBet",
+						"Result":      outcome,
+						"PlayerID":    bb.Bettor.ID,
+						"BoxPlayerID": b.Player.ID,
+						"Seat":        b.Seat,
+						"HandIndex":   h.Index,
+						"RoundID":     g.RoundId,
+						"WagerAmount": stake,
+						"Payout":      payout,
+						"LocalWallet": bb.Bettor.LocalWallet,
+					},
+				})
+			}
+		}
+	})
+}
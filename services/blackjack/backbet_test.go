@@ -0,0 +1,100 @@
+package blackjack
+
+import "testing"
+
+// Places a bet of 10 behind the first seat for a new participant.
+func betBehind(t *testing.T, g *Game, id string, policy BackBetPolicy) *Player {
+	t.Helper()
+	bettor := NewPlayer(id, "Behind "+id)
+	if err := g.PlaceBackBet(bettor, 1, 10, policy); err != nil {
+		t.Fatal(err)
+	}
+	return bettor
+}
+
+func TestBackBetFollowsSplit(t *testing.T) {
+	// 8,8 against a dealer 10/7 is split into 8,10 and 8,10.
+	g, p := openRound(t, DefaultPreset, 10, []string{"8", "10", "8", "7", "10", "10"})
+	follower := betBehind(t, g, "2", BackBetPolicy{FollowSplits: true})
+	stayer := betBehind(t, g, "3", BackBetPolicy{})
+	deal(g, p)
+	playRound(t, g, p, []play{{0, Split{}}, {0, Stand{}}, {1, Stand{}}})
+
+	if want := 10000 + 20; follower.LocalWallet != want {
+		t.Fatalf("follower wallet = %d, want %d", follower.LocalWallet, want)
+	}
+	if want := 10000 + 10; stayer.LocalWallet != want {
+		t.Fatalf("stayer wallet = %d, want %d", stayer.LocalWallet, want)
+	}
+}
+
+func TestBackBetOriginalBetsOnly(t *testing.T) {
+	obo := func(c *GameConfig) { c.Rules.OriginalBetsOnly = true }
+	tests := []struct {
+		name   string
+		ranks  []string // Player, dealer up, player, draws, then the dealer's second card
+		plays  []play
+		policy BackBetPolicy
+	}{
+		{"double followed", []string{"6", "K", "5", "9", "A"}, []play{{0, Double{}}}, BackBetPolicy{FollowDoubles: true}},
+		{"double not followed", []string{"6", "K", "5", "9", "A"}, []play{{0, Double{}}}, BackBetPolicy{}},
+		{"split followed", []string{"8", "K", "8", "3", "4", "A"}, []play{{0, Split{}}}, BackBetPolicy{FollowSplits: true}},
+		{"split not followed", []string{"8", "K", "8", "3", "4", "A"}, []play{{0, Split{}}}, BackBetPolicy{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := openRound(t, "european", 10, tt.ranks, obo)
+			bettor := betBehind(t, g, "2", tt.policy)
+			deal(g, p)
+			playRound(t, g, p, tt.plays)
+			if g.Dealer.Hand.Status != Blackjack {
+				t.Fatalf("dealer = %v, want blackjack", g.Dealer.Hand.Cards)
+			}
+			// Only the original back bet is lost; whatever followed the box
+			// player's double or split is returned.
+			if bettor.LocalWallet != 9990 {
+				t.Fatalf("wallet = %d, want 9990", bettor.LocalWallet)
+			}
+		})
+	}
+}
+
+func TestPlaceBackBet(t *testing.T) {
+	g, p := openRound(t, DefaultPreset, 10, nil)
+	if err := g.PlaceBackBet(p, 1, 10, BackBetPolicy{}); err == nil {
+		t.Fatal("seated player bet behind their own box")
+	}
+	if err := g.PlaceBackBet(NewPlayer("2", "Behind"), 2, 10, BackBetPolicy{}); err == nil {
+		t.Fatal("bet behind an empty seat")
+	}
+	if err := g.PlaceBackBet(NewPlayer("2", "Behind"), 1, 1, BackBetPolicy{}); err == nil {
+		t.Fatal("bet behind below the table minimum")
+	}
+}
+
+func TestBackBetHoldsSeat(t *testing.T) {
+	g, _ := openRound(t, DefaultPreset, 10, nil)
+	betBehind(t, g, "2", BackBetPolicy{})
+	g.BoxAt(1).Bet = 0
+	if err := g.LeaveSeat(1); err == nil {
+		t.Fatal("left a seat with wagers behind it")
+	}
+}
+
+func TestBackBetReturnedWithBox(t *testing.T) {
+	g := openTable(t, 2)
+	p := NewPlayer("1", "Tester")
+	if err := g.JoinSeat(p, 1); err != nil {
+		t.Fatal(err)
+	}
+	g.State = StateBetsOpen
+	bettor := betBehind(t, g, "2", BackBetPolicy{})
+	g.returnWagers(g.BoxAt(1))
+
+	if bettor.LocalWallet != 10000 || bettor.TotalBet != 0 {
+		t.Fatalf("wallet %d and total bet %d, want 10000 and 0", bettor.LocalWallet, bettor.TotalBet)
+	}
+	if len(g.BoxAt(1).Behind) != 0 {
+		t.Fatal("back bet left on the box")
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"casino/libs/store"
 	"fmt"
 	"log"
-	"math"
 	"os"
 	"strconv"
 )
@@ -13,16 +12,18 @@ import (
 //	----- Place Bets -----
 
 /*
-Players make their initial bets before cards are dealt.
-Basic checks against the player wallet and table min/max.
+Players make their initial bets before cards are dealt, one wager per box.
+Basic checks against the player wallet and table min/max, applied to each
+box on its own.
 */
 func (g *Game) PlaceBets() {
 	if g.State != StateBetsOpen {
 		return
 	}
 	scanner := bufio.NewScanner(os.Stdin)
-	g.DoForEachPlayer(func(p *Player) {
-		fmt.Printf("\n%s, Place a wager: ", p.Name)
+	g.DoForEachBox(func(b *Box) {
+		p := b.Player
+		fmt.Printf("\n%s (seat %d), Place a wager: ", p.Name, b.Seat)
 		if !scanner.Scan() {
 			if err := scanner.Err(); err != nil {
 				fmt.Printf("input error while reading wager for %s: %v", p.Name, err)
@@ -37,125 +38,229 @@ func (g *Game) PlaceBets() {
 			log.Printf("failed to convert %q to int: %v", cmd, err)
 			return
 		}
-		p.Wager(betAmount)
-		e := store.Event{
-			Type: string(g.State),
-			Payload: map[string]any{
-				"Player":  p.Name,
-				"Wager":   betAmount,
-				"RoundID": g.RoundId,
-			},
+		if err := g.PlaceBet(b.Seat, betAmount); err != nil {
+			fmt.Println("error:", err)
+			return
 		}
-		g.Store.Append(e)
 
+		g.promptSideBets(scanner, b)
 	})
 	g.State = StateBetsClosed
 }
 
+// Places the main wager on a box.
+func (g *Game) PlaceBet(seat int, amount int) error {
+	if g.State != StateBetsOpen {
+		return &StateError{Action: "bet", State: g.State}
+	}
+	b := g.BoxAt(seat)
+	if b == nil {
+		return fmt.Errorf("%w: seat %d", ErrSeatEmpty, seat)
+	}
+	if b.Bet > 0 {
+		return fmt.Errorf("%w: seat %d already has a wager", ErrSeatInUse, seat)
+	}
+	if err := checkAmount("wager", amount, g.Config.MinWager, g.Config.MaxWager); err != nil {
+		return err
+	}
+	if err := checkFunds(b.Player, amount); err != nil {
+		return err
+	}
+	if g.variant().BoxesPerPlayer > 1 {
+		for _, other := range g.BoxesFor(b.Player) {
+			if other.Bet > 0 && other.Bet != amount {
+				return &AmountError{Wager: "wager", Amount: amount, Min: other.Bet, Max: other.Bet}
+			}
+		}
+	}
+
+	if err := g.takeWager(b.Player, amount); err != nil {
+		return err
+	}
+	b.Bet = amount
+	e := store.Event{
+		Type: string(g.State),
+		Payload: map[string]any{
+			"Player":  b.Player.Name,
+			"Seat":    seat,
+			"Wager":   amount,
+			"RoundID": g.RoundId,
+		},
+	}
+	g.Store.Append(e)
+	return nil
+}
+
+// Prompts a box for each side bet the table offers before the deal.
+func (g *Game) promptSideBets(scanner *bufio.Scanner, b *Box) {
+	for _, t := range g.Config.OfferedSideBets() {
+		def, ok := LookupSideBet(t)
+		if !ok || def.Window != StateBetsOpen {
+			continue
+		}
+		fmt.Printf("\n%s (seat %d), %s side bet (0 for none): ", b.Player.Name, b.Seat, t)
+		if !scanner.Scan() {
+			return
+		}
+		amount, err := strconv.Atoi(scanner.Text())
+		if err != nil || amount <= 0 {
+			continue
+		}
+		bet := PlaceSideBet{Type: t, Amount: amount, Seat: b.Seat}
+		if _, err := ApplyAction(g, b.Player.ID, bet, NoHand); err != nil {
+			fmt.Println("error:", err)
+		}
+	}
+}
+
+// Takes a wager from the player and funds any linked progressive.
+func (g *Game) takeWager(p *Player, amount int) error {
+	if err := p.Wager(amount); err != nil {
+		return err
+	}
+	if g.Progressive != nil {
+		g.Progressive.Contribute(amount)
+	}
+	return nil
+}
+
 //	----- Settle -----
 
 /*
 Evaluates scores, outcomes and payouts.
+House-funded free stake on a winning hand is paid but never returned.
 */
 func (g *Game) Settle() {
 	if g.State != StateBetsSettle {
 		return
 	}
-	dScore := g.Dealer.Hand.Value()
-
-	// Settle Insurance Bets
-	g.DoForEachPlayer(func(p *Player) {
-		h := p.Hands[0]
-		if len(h.SideBets) == 0 {
-			return
-		}
-
-		insuranceSideBet := latestUnpaidSideBet(h.SideBets, InsuranceBet)
-
-		if g.Dealer.Hand.Status == Blackjack && !insuranceSideBet.Paid {
-			payout := int(math.Round(float64(insuranceSideBet.Amount) * float64(g.Config.InsurancePayout)))
-			payout += insuranceSideBet.Amount
-			insuranceSideBet.MarkPaid()
-			p.LocalWallet += payout
-			g.Store.Append(store.Event{
-				Type: string(g.State),
-				Payload: map[string]any{
-					"BetType":     InsuranceBet,
-					"Result":      Win,
-					"PlayerID":    p.ID,
-					"RoundID":     g.RoundId,
-					"WagerAmount": insuranceSideBet.Amount,
-					"LocalWallet": p.LocalWallet,
-				},
-			})
-		} else {
-			g.Store.Append(store.Event{
-				Type: string(g.State),
-				Payload: map[string]any{
-					"BetType":     InsuranceBet,
-					"Result":      Loss,
-					"PlayerID":    p.ID,
-					"RoundID":     g.RoundId,
-					"WagerAmount": insuranceSideBet.Amount,
-					"LocalWallet": p.LocalWallet,
-				},
-			})
-		}
-	})
+	// Settle Side Bets, including insurance
+	g.resolveOpenSideBets()
 
 	// Settle Main Bets
 	g.DoForEachPlayer(func(p *Player) {
 		for i := range p.Hands {
 			h := p.Hands[i]
-			wager := h.Bet
-			var payout int
-			outcome := EvaluateOutcome(h.Value(), h.Status, dScore, g.Dealer.Hand.Status)
-			switch outcome {
-			case Win:
-				if h.Status == Blackjack {
-					payout = int(float64(wager) * g.Config.BlackjackPayout)
-				} else {
-					payout = wager * g.Config.Payout
-				}
-				payout += wager
-				p.LocalWallet += payout
-			case Push:
-				p.LocalWallet += wager
-			case Loss:
-				if h.Status == Surrendered {
-					wager = wager / 2
-					p.LocalWallet += wager
-				}
+			if h.Status == Settled {
+				continue
 			}
+			outcome, payout := g.settleStake(h, Stake{Amount: h.Bet, Doubled: h.DoubleAmount})
+			freePayout := 0
+			if outcome == Win && h.FreeBet > 0 {
+				freePayout = h.FreeBet * g.Config.Payout
+			}
+			p.LocalWallet += payout + freePayout
 
-			e := store.Event{
-				Type: string(g.State),
-				Payload: map[string]any{
-					"BetType":     "Standard",
-					"Result":      outcome,
-					"PlayerID":    p.ID,
-					"RoundID":     g.RoundId,
-					"WagerAmount": wager,
-					"LocalWallet": p.LocalWallet,
-				},
+			payload := map[string]any{
+				"BetType":     "Standard",
+				"Result":      outcome,
+				"PlayerID":    p.ID,
+				"RoundID":     g.RoundId,
+				"Seat":        h.Seat,
+				"HandIndex":   h.Index,
+				"WagerAmount": h.Bet,
+				"Payout":      payout,
+				"LocalWallet": p.LocalWallet,
+			}
+			if h.Status == Surrendered {
+				payload["Surrender"] = h.SurrenderedAs
+			}
+			if h.FreeBet > 0 {
+				payload["FreeBet"] = h.FreeBet
+				payload["FreePayout"] = freePayout
 			}
-			g.Store.Append(e)
+			g.Store.Append(store.Event{Type: string(g.State), Payload: payload})
 		}
 	})
 
+	// Settle Back Bets
+	g.settleBackBets()
+
 	if g.Dealer.Shoe.reshuffle {
 		g.ReshuffleShoe()
 	}
 	g.State = StateBetsOpen
 }
 
-// Prompts players for insurance.
+// A stake riding a hand: the box player's bet or a bet behind the box.
+type Stake struct {
+	Amount  int // Total riding the hand
+	Doubled int // Part of Amount added by doubling the hand
+}
+
+// Returns the outcome of a hand and the amount returned on a stake riding it,
+// stake included.
+func (g *Game) settleStake(h *Hand, stake Stake) (Outcome, int) {
+	outcome := EvaluateOutcome(h.Value(), h.Status, g.Dealer.Hand.Value(), g.Dealer.Hand.Status)
+	variant := g.variant()
+	if variant.Outcome != nil {
+		outcome = variant.Outcome(g, h, outcome)
+	}
+	if variant.Payout != nil {
+		if payout, ok := variant.Payout(g, h, stake, outcome); ok {
+			return outcome, payout
+		}
+	}
+	switch outcome {
+	case Win:
+		if h.Status == Blackjack {
+			return outcome, int(float64(stake.Amount)*g.Config.BlackjackPayout) + stake.Amount
+		}
+		return outcome, stake.Amount*g.Config.Payout + stake.Amount
+	case Push:
+		return outcome, stake.Amount
+	}
+
+	if g.surrenderRefunded(h) {
+		return outcome, stake.Amount / 2
+	}
+	return outcome, g.originalBetsOnlyRefund(h, stake)
+}
+
+// Half the stake is returned on surrender, except a late surrender at a
+// no-hole-card table which the dealer's blackjack still beats.
+func (g *Game) surrenderRefunded(h *Hand) bool {
+	if h.Status != Surrendered {
+		return false
+	}
+	if h.SurrenderedAs == SurrenderLate && g.Dealer.Hand.Status == Blackjack {
+		return false
+	}
+	return true
+}
+
+// At OBO no-hole-card tables a dealer blackjack only takes the original
+// bet; split and double stakes are returned.  Returns the amount returned.
+func (g *Game) originalBetsOnlyRefund(h *Hand, stake Stake) int {
+	rules := g.Config.Rules
+	if !rules.NoHoleCard || !rules.OriginalBetsOnly || g.Dealer.Hand.Status != Blackjack {
+		return 0
+	}
+	if b := g.BoxAt(h.Seat); h.IsSplit && (b == nil || b.Hand != h) {
+		return stake.Amount
+	}
+	return stake.Doubled
+}
+
+// Prompts players for insurance, or even money on blackjack.
+// Nothing is offered when the dealer's cards are exposed.
 func (g *Game) OfferInsurance() {
+	if g.variant().DealerExposed {
+		return
+	}
 	fmt.Println("Insurance open.")
 
 	scanner := bufio.NewScanner(os.Stdin)
-	g.DoForEachPlayer(func(p *Player) {
-		fmt.Printf("\n%s, Insurance? (y/n): ", p.Name)
+	g.DoForEachBoxInPlay(func(b *Box) {
+		p, h := b.Player, b.Hand
+		if h.Status == Surrendered {
+			return
+		}
+		if h.Status == Blackjack {
+			g.offerEvenMoney(scanner, p, h)
+			return
+		}
+		fmt.Printf("\n%s, Insurance? (y/n/amount): ", p.Name)
 		if !scanner.Scan() {
 			if err := scanner.Err(); err != nil {
 				fmt.Printf("input error while reading insurance prompt for %s: %v", p.Name, err)
@@ -168,7 +273,9 @@ func (g *Game) OfferInsurance() {
 		var err error
 		endTurn := false
 		if response == "y" {
-			endTurn, err = ApplyAction(g, p.ID, Insurance{}, p.Hands[0])
+			endTurn, err = ApplyAction(g, p.ID, Insurance{}, h.Index)
+		} else if amount, convErr := strconv.Atoi(response); convErr == nil && amount > 0 {
+			endTurn, err = ApplyAction(g, p.ID, Insurance{Amount: amount}, h.Index)
 		}
 
 		if err != nil {
@@ -183,6 +290,25 @@ func (g *Game) OfferInsurance() {
 	fmt.Println("Insurance closed.")
 }
 
+// Prompts a player holding blackjack for even money.
+func (g *Game) offerEvenMoney(scanner *bufio.Scanner, p *Player, h *Hand) {
+	fmt.Printf("\n%s, Even money? (y/n): ", p.Name)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			fmt.Printf("input error while reading even money prompt for %s: %v", p.Name, err)
+		} else {
+			fmt.Printf("no more input (EOF) while reading even money prompt for %s", p.Name)
+		}
+		return
+	}
+	if scanner.Text() != "y" {
+		return
+	}
+	if _, err := ApplyAction(g, p.ID, EvenMoney{}, h.Index); err != nil {
+		fmt.Println("error:", err)
+	}
+}
+
 // Evaulates and returns player WIN, LOSS or PUSH.
 func EvaluateOutcome(pScore int, pHandStatus HandStatus, dScore int, dHandStatus HandStatus) Outcome {
 	// Surrender
@@ -0,0 +1,33 @@
+package blackjack
+
+import "testing"
+
+func TestOriginalBetsOnly(t *testing.T) {
+	obo := func(c *GameConfig) { c.Rules.OriginalBetsOnly = true }
+	double := []play{{0, Double{}}}
+	split := []play{{0, Split{}}}
+	tests := []struct {
+		name      string
+		overrides []ConfigOverride
+		ranks     []string // Player, dealer up, player, draws, then the dealer's second card
+		plays     []play
+		want      int
+	}{
+		{"double refunded", []ConfigOverride{obo}, []string{"6", "K", "5", "9", "A"}, double, 9990},
+		{"double lost", nil, []string{"6", "K", "5", "9", "A"}, double, 9980},
+		{"split refunded", []ConfigOverride{obo}, []string{"8", "K", "8", "3", "4", "A"}, split, 9990},
+		{"split lost", nil, []string{"8", "K", "8", "3", "4", "A"}, split, 9980},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := dealRound(t, "european", 10, tt.ranks, tt.overrides...)
+			playRound(t, g, p, tt.plays)
+			if g.Dealer.Hand.Status != Blackjack {
+				t.Fatalf("dealer = %v, want blackjack", g.Dealer.Hand.Cards)
+			}
+			if p.LocalWallet != tt.want {
+				t.Fatalf("wallet = %d, want %d", p.LocalWallet, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package blackjack
+
+import "testing"
+
+// Opens a table from the default preset with one player in the given seats
+// and stacks the shoe to deal the given ranks in order.  Betting is open.
+func openBoxes(t *testing.T, seats []int, ranks []string, overrides ...ConfigOverride) (*Game, *Player) {
+	t.Helper()
+	g := openTable(t, MaxSeats)
+	for _, override := range overrides {
+		override(g.Config)
+	}
+	p := NewPlayer("1", "Tester")
+	for _, seat := range seats {
+		if err := g.JoinSeat(p, seat); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g.Dealer.Shoe = stackedShoe(ranks...)
+	g.State = StateBetsOpen
+	return g, p
+}
+
+// Closes betting, deals and queues every hand dealt in.
+func dealBoxes(g *Game) {
+	g.State = StateBetsClosed
+	g.DealCards()
+	if g.State == StatePlayerTurn {
+		g.DoForEachBoxInPlay(func(b *Box) {
+			g.Enqueue(Turn{Player: b.Player, Hand: b.Hand})
+		})
+	}
+}
+
+func TestBoxesPlaySeparately(t *testing.T) {
+	// Seat 1 is dealt 10,10 and seat 2 is dealt 6,10 against the dealer's 10,8.
+	g, p := openBoxes(t, []int{1, 2}, []string{"10", "6", "10", "10", "10", "8"})
+	for _, seat := range []int{1, 2} {
+		if err := g.PlaceBet(seat, 10*seat); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dealBoxes(g)
+
+	if len(p.Hands) != 2 || len(g.TurnQueue) != 2 {
+		t.Fatalf("%d hands and %d turns, want 2 of each", len(p.Hands), len(g.TurnQueue))
+	}
+	for i, b := range g.BoxesFor(p) {
+		if b.Hand != p.Hands[i] || b.Hand.Seat != b.Seat {
+			t.Fatalf("box %d does not hold hand %d", b.Seat, i)
+		}
+	}
+	playRound(t, g, p, []play{{0, Stand{}}, {1, Stand{}}})
+
+	// Seat 1 wins 10; seat 2 loses 20.
+	if want := 10000 - 30 + 20; p.LocalWallet != want {
+		t.Fatalf("wallet = %d, want %d", p.LocalWallet, want)
+	}
+}
+
+func TestDealSkipsBoxWithoutWager(t *testing.T) {
+	g, p := openBoxes(t, []int{1, 2}, []string{"10", "10", "10", "8"})
+	if err := g.PlaceBet(2, 10); err != nil {
+		t.Fatal(err)
+	}
+	dealBoxes(g)
+
+	if g.BoxAt(1).Hand != nil {
+		t.Fatal("box without a wager was dealt in")
+	}
+	if len(p.Hands) != 1 || len(g.TurnQueue) != 1 || p.Hands[0].Seat != 2 {
+		t.Fatalf("%d hands and %d turns, want one of each for seat 2", len(p.Hands), len(g.TurnQueue))
+	}
+	if got := p.Hands[0].Value(); got != 20 {
+		t.Fatalf("seat 2 value = %d, want 20", got)
+	}
+}
+
+func TestReturnWagers(t *testing.T) {
+	g, p := openBoxes(t, []int{1}, nil, offerSuited)
+	if err := g.PlaceBet(1, 10); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (PlaceSideBet{Type: suitedBet, Amount: 5}).Execute(g, p, nil); err != nil {
+		t.Fatal(err)
+	}
+	b := g.BoxAt(1)
+	g.returnWagers(b)
+
+	if p.LocalWallet != 10000 || p.TotalBet != 0 {
+		t.Fatalf("wallet %d and total bet %d, want 10000 and 0", p.LocalWallet, p.TotalBet)
+	}
+	if b.Bet != 0 || len(b.SideBets) != 0 {
+		t.Fatal("wagers left on the box")
+	}
+}
+
+func TestSideBetNeedsMainWager(t *testing.T) {
+	g, p := openBoxes(t, []int{1}, nil, offerSuited)
+	if _, err := (PlaceSideBet{Type: suitedBet, Amount: 5}).Execute(g, p, nil); err == nil {
+		t.Fatal("side bet placed on a box without a wager")
+	}
+	if p.LocalWallet != 10000 {
+		t.Fatalf("wallet = %d, want 10000", p.LocalWallet)
+	}
+}
+
+func TestLeaveSeatWithSideBets(t *testing.T) {
+	g, p := openBoxes(t, []int{1}, nil, offerSuited)
+	if err := g.PlaceBet(1, 10); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (PlaceSideBet{Type: suitedBet, Amount: 5}).Execute(g, p, nil); err != nil {
+		t.Fatal(err)
+	}
+	g.BoxAt(1).Bet = 0
+	if err := g.LeaveSeat(1); err == nil {
+		t.Fatal("left with side bets on the table")
+	}
+}
@@ -0,0 +1,65 @@
+package blackjack
+
+//	----- Buster Blackjack -----
+
+/*
+Pays when the dealer busts, by the number of cards in the busted hand.
+Placed while bets are open and resolved at the end of the dealer's turn.
+The dealer completes the hand while a live bet exists, even when every
+player has busted.
+*/
+
+const (
+	BustThreeCards = "BUST_3_CARDS"
+	BustFourCards  = "BUST_4_CARDS"
+	BustFiveCards  = "BUST_5_CARDS"
+	BustSixCards   = "BUST_6_CARDS"
+	BustSevenCards = "BUST_7_CARDS"
+	BustEightPlus  = "BUST_8_PLUS_CARDS"
+)
+
+func init() {
+	RegisterSideBet(SideBetDefinition{
+		Type:               DealerBustBet,
+		Window:             StateBetsOpen,
+		Trigger:            ResolveAfterDealerTurn,
+		RequiresDealerHand: true,
+		Paytable: func(cfg *GameConfig) Paytable {
+			return Paytable{
+				BustThreeCards: 1,
+				BustFourCards:  2,
+				BustFiveCards:  9,
+				BustSixCards:   50,
+				BustSevenCards: 100,
+				BustEightPlus:  250,
+			}
+		},
+		Resolve: func(g *Game, p *Player, h *Hand) string {
+			d := g.Dealer.Hand
+			if d.ValueAll() <= 21 {
+				return ""
+			}
+			return busterTier(len(d.Cards))
+		},
+	})
+}
+
+// Returns the Buster tier for the number of cards the dealer busted with.
+func busterTier(cards int) string {
+	switch {
+	case cards >= 8:
+		return BustEightPlus
+	case cards == 7:
+		return BustSevenCards
+	case cards == 6:
+		return BustSixCards
+	case cards == 5:
+		return BustFiveCards
+	case cards == 4:
+		return BustFourCards
+	case cards == 3:
+		return BustThreeCards
+	default:
+		return ""
+	}
+}
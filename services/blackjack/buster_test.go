@@ -0,0 +1,50 @@
+package blackjack
+
+import "testing"
+
+func TestBusterTier(t *testing.T) {
+	tests := []struct {
+		cards int
+		want  string
+	}{
+		{2, ""},
+		{3, BustThreeCards},
+		{5, BustFiveCards},
+		{7, BustSevenCards},
+		{9, BustEightPlus},
+	}
+	for _, tt := range tests {
+		if got := busterTier(tt.cards); got != tt.want {
+			t.Errorf("%d cards: tier = %q, want %q", tt.cards, got, tt.want)
+		}
+	}
+}
+
+func TestBusterDealerPlaysOut(t *testing.T) {
+	offer := func(c *GameConfig) {
+		c.SideBets = map[SideBetType]Paytable{DealerBustBet: nil}
+	}
+	for _, placed := range []bool{true, false} {
+		// The player busts on 10,6,10; the dealer's 6,4 draws 5 and 10 to bust on four cards.
+		g, p := openRound(t, DefaultPreset, 10, []string{"10", "6", "6", "4", "10", "5", "10"}, offer)
+		if placed {
+			if _, err := (PlaceSideBet{Type: DealerBustBet, Amount: 5}).Execute(g, p, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		deal(g, p)
+		playRound(t, g, p, []play{{0, Hit{}}})
+
+		// The dealer only plays out the hand while the bet is live.
+		wantCards, wantWallet := 2, 9990
+		if placed {
+			wantCards, wantWallet = 4, 9985+5*2+5
+		}
+		if len(g.Dealer.Hand.Cards) != wantCards {
+			t.Errorf("placed %v: dealer drew to %v, want %d cards", placed, g.Dealer.Hand.Cards, wantCards)
+		}
+		if p.LocalWallet != wantWallet {
+			t.Errorf("placed %v: wallet = %d, want %d", placed, p.LocalWallet, wantWallet)
+		}
+	}
+}
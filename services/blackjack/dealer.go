@@ -17,12 +17,22 @@ func NewDealer(name string) *Dealer {
 }
 
 func (d *Dealer) RevealHoleCard() {
-	if len(d.Hand.Cards) > 1 {
+	if len(d.Hand.Cards) > 1 && d.Hand.Cards[1].Hidden {
 		fmt.Print("Revealing hidden card...")
 		d.Hand.Cards[1].Hidden = false
 	}
 }
 
+// Returns the dealer's first face-up card.
+func (d *Dealer) UpCard() (Card, bool) {
+	for _, c := range d.Hand.Cards {
+		if !c.Hidden {
+			return c, true
+		}
+	}
+	return Card{}, false
+}
+
 func (d *Dealer) ClearHand() {
 	if d.Hand == nil {
 		d.Hand = &Hand{}
@@ -31,13 +41,24 @@ func (d *Dealer) ClearHand() {
 	d.Hand.Status = Qualified
 }
 
-// Dealer checks for insurance and blackjack.
+// Dealer checks for early surrender, insurance and blackjack.
+// Without a hole card there is nothing to peek at; insurance is
+// still offered against an Ace and resolved after the dealer's turn.
+// With both cards exposed a dealer blackjack is settled at once.
 func (g *Game) dealerPeek() {
+	if g.variant().DealerExposed {
+		g.checkBlackjack()
+		return
+	}
 	for _, card := range g.Dealer.Hand.Cards {
-		if g.State != StateDealCards {
+		if g.State != StateDealCards && g.State != StateSwitchTurn {
 			break
 		}
 		if !card.Hidden {
+			if g.Config.Rules.EarlySurrenderAgainst(card) {
+				g.State = StateSurrenderTurn
+				g.OfferEarlySurrender()
+			}
 			switch card.Rank {
 			case "A":
 				g.State = StateInsuranceTurn
@@ -54,11 +75,20 @@ func (g *Game) dealerPeek() {
 
 // Dealer checks for blackjack.
 func (g *Game) checkBlackjack() {
-	if g.State != StateDealCards && g.State != StateInsuranceTurn {
+	switch g.State {
+	case StateDealCards, StateSwitchTurn, StateSurrenderTurn, StateInsuranceTurn:
+	default:
+		return
+	}
+
+	if g.Config.Rules.NoHoleCard {
+		g.State = StatePlayerTurn
 		return
 	}
 
-	fmt.Println("Dealer peeking...")
+	if !g.variant().DealerExposed {
+		fmt.Println("Dealer peeking...")
+	}
 	if g.Dealer.Hand.ValueAll() == 21 {
 		g.Dealer.RevealHoleCard()
 		g.Dealer.Hand.Status = Blackjack
@@ -55,6 +55,17 @@ func NewDeck() *Deck {
 	return &Deck{cards: cards}
 }
 
+// Returns a copy of the deck with every card of the given rank removed.
+func (d *Deck) Without(rank string) *Deck {
+	cards := make([]Card, 0, len(d.cards))
+	for _, c := range d.cards {
+		if c.Rank != rank {
+			cards = append(cards, c)
+		}
+	}
+	return &Deck{cards: cards}
+}
+
 /*
 Creates a shoe of one or more shuffled decks.
 Penetratation percentage determines how much of the shoe
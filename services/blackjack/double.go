@@ -8,35 +8,115 @@ import (
 )
 
 /*
-The player places an additional bet equal to their original stake.
-One card is drawn and ends the turn.  Available only on the first action
-of a turn.
+The player places an additional bet of up to their original stake.
+One card is drawn and ends the turn, unless the variant lets the player
+rescue the doubled hand by surrendering it.  Available only on the first action
+of a turn, unless Rules.DoubleAnyCards allows doubling on three or more cards,
+on the totals permitted by Rules.DoubleOn, and after a split only when
+Rules.DoubleAfterSplit is set.  An Amount of zero doubles for the full stake;
+anything less is a double-for-less.  A double the variant makes free is always
+for the full stake and is funded by the house.
 */
-type Double struct{}
+type Double struct {
+	Amount int
+}
 
-func (Double) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+func (d Double) Validate(g *Game, p *Player, h *Hand) error {
 	if g.State != StatePlayerTurn {
-		return false, fmt.Errorf("cannot double while in %s", g.State)
+		return &StateError{Action: "double", State: g.State}
+	}
+
+	rules := g.Config.Rules
+	if !h.CanDouble(rules) {
+		switch {
+		case h.Status != Qualified:
+			return ErrHandNotInPlay
+		case h.DoubleDown:
+			return fmt.Errorf("%w: already doubled", ErrNotAllowed)
+		case h.Stood:
+			return fmt.Errorf("%w: hand already stands", ErrNotAllowed)
+		case rules.DoubleAnyCards:
+			return fmt.Errorf("%w: hand cannot be doubled", ErrNotAllowed)
+		}
+		return fmt.Errorf("%w: double", ErrNotFirstAction)
+	}
+
+	if h.IsSplit && !rules.DoubleAfterSplit {
+		return fmt.Errorf("%w: cannot double after split", ErrNotAllowed)
 	}
 
-	if !h.IsFirstAction() {
-		return false, fmt.Errorf("can only double on first action")
+	if h.IsSplitAces() && !rules.HitSplitAces {
+		return fmt.Errorf("%w: cannot double on split aces", ErrNotAllowed)
 	}
 
-	p.Wager(h.Bet)
+	if !rules.CanDoubleOn(h.Value()) {
+		return fmt.Errorf("%w: cannot double on %d", ErrNotAllowed, h.Value())
+	}
+
+	if g.freeStake(ActionDouble, h) {
+		return nil
+	}
+	additional := d.additional(h)
+	if err := checkAmount("double", additional, 1, h.Stake()); err != nil {
+		return err
+	}
+	if err := checkFunds(p, additional); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Returns the additional stake; zero doubles for the full bet.
+func (d Double) additional(h *Hand) int {
+	if d.Amount == 0 {
+		return h.Stake()
+	}
+	return d.Amount
+}
+
+func (d Double) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+	if err := g.validate(ActionDouble, d, p, h); err != nil {
+		return false, err
+	}
+
+	originalBet := h.Stake()
+	additional := d.additional(h)
+	free := g.freeStake(ActionDouble, h)
+	if free {
+		additional = originalBet
+		h.FreeBet += additional
+		p.FreeBets += additional
+	} else {
+		if err := g.takeWager(p, additional); err != nil {
+			return false, err
+		}
+		h.DoubleAmount = additional
+		h.Bet += additional
+	}
+	g.followDouble(h, originalBet, additional)
 	h.DoubleDown = true
-	h.Bet += h.Bet
-	card := g.Dealer.Shoe.Draw()
+	card := g.draw()
 	h.Cards = append(h.Cards, card)
+	g.recordDealt(h, card)
 
 	e := store.Event{
 		Type: "Double",
 		Payload: map[string]any{
-			"PlayerID": p.ID,
-			"TotalBet": p.TotalBet,
-			"Card":     card,
+			"PlayerID":    p.ID,
+			"HandIndex":   h.Index,
+			"OriginalBet": originalBet,
+			"Additional":  additional,
+			"ForLess":     additional < originalBet,
+			"Free":        free,
+			"TotalBet":    p.TotalBet,
+			"Card":        card,
 		},
 	}
 	g.Store.Append(e)
-	return true, nil
+	if h.Value() > 21 {
+		h.Bust()
+	}
+	// The turn stays open only while the variant offers a rescue.
+	endTurn := h.Status != Qualified || h.Value() == 21 || g.validate(ActionSurrender, Surrender{}, p, h) != nil
+	return endTurn, nil
 }
@@ -0,0 +1,58 @@
+package blackjack
+
+import "testing"
+
+func TestDoubleForLess(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int
+		wantErr bool
+		want    int // Wallet once the double wins
+	}{
+		{"full stake", 0, false, 10020},
+		{"for less", 4, false, 10014},
+		{"over the stake", 11, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 6,5 doubles into 20 against a dealer 9,7 who draws to 26.
+			g, p := dealRound(t, DefaultPreset, 10, []string{"6", "9", "5", "7", "9", "10"})
+			_, err := (Double{Amount: tt.amount}).Execute(g, p, p.Hands[0])
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			playRound(t, g, p, nil)
+			if p.LocalWallet != tt.want {
+				t.Fatalf("wallet = %d, want %d", p.LocalWallet, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoubleAnyCards(t *testing.T) {
+	for _, anyCards := range []bool{true, false} {
+		g, p := dealRound(t, DefaultPreset, 10, []string{"2", "9", "3", "7", "4", "5"})
+		g.Config.Rules.DoubleAnyCards = anyCards
+		h := p.Hands[0]
+		if _, err := (Hit{}).Execute(g, p, h); err != nil {
+			t.Fatal(err)
+		}
+		_, err := (Double{}).Execute(g, p, h)
+		if (err == nil) != anyCards {
+			t.Errorf("DoubleAnyCards %v: doubling three cards err = %v", anyCards, err)
+		}
+	}
+}
+
+func TestOriginalBetsOnlyDoubleForLess(t *testing.T) {
+	obo := func(c *GameConfig) { c.Rules.OriginalBetsOnly = true }
+	g, p := dealRound(t, "european", 10, []string{"6", "K", "5", "9", "A"}, obo)
+	// Only the 4 added by the double is returned on the dealer's blackjack.
+	playRound(t, g, p, []play{{0, Double{Amount: 4}}})
+	if p.LocalWallet != 9990 {
+		t.Fatalf("wallet = %d, want 9990", p.LocalWallet)
+	}
+}
@@ -0,0 +1,34 @@
+package blackjack
+
+import (
+	// Standard libs
+	"fmt"
+)
+
+//	----- Double Exposure -----
+
+/*
+Both dealer cards are dealt face up.  With nothing hidden there is no
+insurance or even money, and the dealer wins every tie except a tie of
+blackjacks, which pushes.  Blackjack pays even money.
+*/
+
+func init() {
+	RegisterVariant(VariantDefinition{
+		Variant:       DoubleExposure,
+		DealerExposed: true,
+		Legal: func(g *Game, name ActionName, p *Player, h *Hand, err error) error {
+			if name == ActionInsurance || name == ActionEvenMoney {
+				return fmt.Errorf("%w: %s with both dealer cards exposed", ErrNotOffered, name)
+			}
+			return err
+		},
+		Outcome: func(g *Game, h *Hand, outcome Outcome) Outcome {
+			if outcome == Push && h.Status != Blackjack {
+				return Loss
+			}
+			return outcome
+		},
+		Payout: blackjackPaysEvenMoney,
+	})
+}
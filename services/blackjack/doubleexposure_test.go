@@ -0,0 +1,49 @@
+package blackjack
+
+import (
+	// Standard libs
+	"errors"
+	"testing"
+)
+
+func TestDoubleExposureDealsFaceUp(t *testing.T) {
+	g, _ := dealRound(t, "double-exposure", 10, []string{"10", "9", "8", "7"})
+	for _, c := range g.Dealer.Hand.Cards {
+		if c.Hidden {
+			t.Fatalf("dealer card %v dealt face down", c)
+		}
+	}
+}
+
+func TestDoubleExposureTies(t *testing.T) {
+	stand := []play{{0, Stand{}}}
+	tests := []struct {
+		name  string
+		ranks []string // Player, dealer up, player, dealer hole
+		plays []play
+		want  int
+	}{
+		{"dealer wins a tie", []string{"10", "9", "8", "9"}, stand, 9990},
+		{"blackjacks push", []string{"A", "A", "K", "K"}, nil, 10000},
+		{"blackjack pays even money", []string{"A", "10", "K", "9"}, nil, 10010},
+		{"higher hand wins", []string{"10", "9", "K", "8"}, stand, 10010},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := dealRound(t, "double-exposure", 10, tt.ranks)
+			playRound(t, g, p, tt.plays)
+			if p.LocalWallet != tt.want {
+				t.Fatalf("wallet = %d, want %d", p.LocalWallet, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoubleExposureNoInsurance(t *testing.T) {
+	g, p := dealRound(t, "double-exposure", 10, []string{"10", "A", "8", "7"})
+	g.State = StateInsuranceTurn
+	set := LegalActions(g, p.ID, 0)
+	if err := set.Reason(ActionInsurance); !errors.Is(err, ErrNotOffered) {
+		t.Fatalf("insurance: err = %v, want %v", err, ErrNotOffered)
+	}
+}
@@ -0,0 +1,111 @@
+package blackjack
+
+import (
+	// Standard libs
+	"errors"
+	"fmt"
+	// Internal
+	"casino/libs/fsm"
+)
+
+//	----- Errors -----
+
+/*
+Every error returned by the engine wraps one of the sentinels below, so
+callers can test for a class of failure with errors.Is and map it to a
+status code.  Failures that carry useful context return a typed error;
+use errors.As to get at the fields.
+*/
+
+var (
+	ErrUnknownPlayer     = errors.New("unknown player")
+	ErrUnknownHand       = errors.New("unknown hand")
+	ErrUnknownAction     = errors.New("unknown action")
+	ErrUnknownPreset     = errors.New("unknown table preset")
+	ErrInvalidConfig     = errors.New("invalid table configuration")
+	ErrNotYourTurn       = errors.New("not your turn")
+	ErrWrongState        = errors.New("not allowed in current state")
+	ErrNotFirstAction    = errors.New("only allowed on first action")
+	ErrNotAllowed        = errors.New("not allowed by table rules")
+	ErrNotOffered        = errors.New("not offered at this table")
+	ErrHandNotInPlay     = errors.New("hand no longer in play")
+	ErrMaxHands          = errors.New("maximum hands reached")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrInvalidAmount     = errors.New("amount out of range")
+	ErrInvalidSeat       = errors.New("seat does not exist")
+	ErrTableFull         = errors.New("table is full")
+	ErrSeatTaken         = errors.New("seat is taken")
+	ErrSeatEmpty         = errors.New("seat is empty")
+	ErrSeatInUse         = errors.New("seat has bets in play")
+	ErrDuplicatePlayer   = errors.New("player ID already in use")
+)
+
+// Reports an action on a hand that is not at the head of the turn queue.
+type TurnError struct {
+	PlayerID     string
+	Hand         HandIndex
+	TurnPlayerID string
+	TurnHand     HandIndex
+}
+
+func (e *TurnError) Error() string {
+	return fmt.Sprintf("%s: player %s hand %d acted, turn belongs to player %s hand %d",
+		ErrNotYourTurn, e.PlayerID, e.Hand, e.TurnPlayerID, e.TurnHand)
+}
+
+func (e *TurnError) Unwrap() error { return ErrNotYourTurn }
+
+// Reports an action attempted outside the state that allows it.
+type StateError struct {
+	Action string
+	State  fsm.State
+}
+
+func (e *StateError) Error() string {
+	return fmt.Sprintf("cannot %s while in %s", e.Action, e.State)
+}
+
+func (e *StateError) Unwrap() error { return ErrWrongState }
+
+// Reports a stake the player's wallet cannot cover.
+type FundsError struct {
+	PlayerID  string
+	Needed    int
+	Available int
+}
+
+func (e *FundsError) Error() string {
+	return fmt.Sprintf("%s: player %s needs %d, has %d", ErrInsufficientFunds, e.PlayerID, e.Needed, e.Available)
+}
+
+func (e *FundsError) Unwrap() error { return ErrInsufficientFunds }
+
+// Reports an amount outside the limits for a wager.
+type AmountError struct {
+	Wager  string
+	Amount int
+	Min    int
+	Max    int
+}
+
+func (e *AmountError) Error() string {
+	return fmt.Sprintf("%s must be between %d and %d, got %d", e.Wager, e.Min, e.Max, e.Amount)
+}
+
+func (e *AmountError) Unwrap() error { return ErrInvalidAmount }
+
+// Returns a FundsError if the player cannot cover the amount.
+func checkFunds(p *Player, amount int) error {
+	if amount > p.LocalWallet {
+		return &FundsError{PlayerID: p.ID, Needed: amount, Available: p.LocalWallet}
+	}
+	return nil
+}
+
+// Returns an AmountError if the amount falls outside min..max.
+func checkAmount(wager string, amount, min, max int) error {
+	if amount < min || amount > max {
+		return &AmountError{Wager: wager, Amount: amount, Min: min, Max: max}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package blackjack
+
+import (
+	// Standard libs
+	"errors"
+	"testing"
+	// Internal
+	"casino/libs/store"
+)
+
+func TestSeatingErrors(t *testing.T) {
+	g := openTable(t, 2)
+	p := NewPlayer("1", "One")
+	if err := g.JoinSeat(p, 1); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"seat taken", g.JoinSeat(NewPlayer("2", "Two"), 1), ErrSeatTaken},
+		{"no such seat", g.JoinSeat(NewPlayer("2", "Two"), 3), ErrInvalidSeat},
+		{"duplicate ID", g.JoinSeat(NewPlayer("1", "Impostor"), 2), ErrDuplicatePlayer},
+		{"empty seat", g.LeaveSeat(2), ErrSeatEmpty},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", tt.name, tt.err, tt.want)
+		}
+	}
+
+	if _, err := g.JoinTable(NewPlayer("2", "Two")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.JoinTable(NewPlayer("3", "Three")); !errors.Is(err, ErrTableFull) {
+		t.Fatalf("err = %v, want %v", err, ErrTableFull)
+	}
+}
+
+func TestPlaceBetErrors(t *testing.T) {
+	g, p := openRound(t, DefaultPreset, 10, nil)
+	if err := g.PlaceBet(1, 10); !errors.Is(err, ErrSeatInUse) {
+		t.Fatalf("second wager: err = %v, want %v", err, ErrSeatInUse)
+	}
+	if err := g.LeaveSeat(1); !errors.Is(err, ErrSeatInUse) {
+		t.Fatalf("leave: err = %v, want %v", err, ErrSeatInUse)
+	}
+
+	g.BoxAt(1).Bet = 0
+	var amountErr *AmountError
+	if err := g.PlaceBet(1, 1); !errors.As(err, &amountErr) || amountErr.Min != g.Config.MinWager {
+		t.Fatalf("below minimum: err = %v, want an AmountError", err)
+	}
+	var fundsErr *FundsError
+	p.LocalWallet = 20
+	if err := g.PlaceBet(1, 25); !errors.As(err, &fundsErr) || fundsErr.Needed != 25 || fundsErr.Available != 20 {
+		t.Fatalf("short of funds: err = %v, want a FundsError", err)
+	}
+
+	g.State = StateBetsClosed
+	var stateErr *StateError
+	if err := g.PlaceBet(1, 10); !errors.As(err, &stateErr) || stateErr.State != StateBetsClosed {
+		t.Fatalf("bets closed: err = %v, want a StateError", err)
+	}
+}
+
+func TestActionErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranks  []string
+		before []Action
+		action Action
+		want   error
+	}{
+		{"double after a hit", []string{"2", "9", "3", "7", "4"}, []Action{Hit{}}, Double{}, ErrNotFirstAction},
+		{"split an unpaired hand", []string{"2", "9", "3", "7"}, nil, Split{}, ErrNotAllowed},
+		{"insurance against a nine", []string{"2", "9", "3", "7"}, nil, Insurance{}, ErrWrongState},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := dealRound(t, DefaultPreset, 10, tt.ranks)
+			for _, a := range tt.before {
+				if _, err := ApplyAction(g, p.ID, a, 0); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err := ApplyAction(g, p.ID, tt.action, 0); !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnknownPreset(t *testing.T) {
+	if _, err := NewGameFromPreset(store.NewEventStore(), "no-such-table"); !errors.Is(err, ErrUnknownPreset) {
+		t.Fatalf("err = %v, want %v", err, ErrUnknownPreset)
+	}
+}
@@ -0,0 +1,54 @@
+package blackjack
+
+import (
+	// Standard libs
+	"fmt"
+	// Internal
+	"casino/libs/store"
+)
+
+/*
+A player holding blackjack against a dealer Ace may take even money.
+The hand is settled immediately at 1:1, before the dealer peeks,
+and is skipped by the settlement of main bets.
+*/
+type EvenMoney struct{}
+
+func (EvenMoney) Validate(g *Game, p *Player, h *Hand) error {
+	if g.State != StateInsuranceTurn {
+		return &StateError{Action: "take even money", State: g.State}
+	}
+
+	if h.Status != Blackjack {
+		return fmt.Errorf("%w: even money only offered on blackjack", ErrNotAllowed)
+	}
+
+	if up, ok := g.Dealer.UpCard(); !ok || up.Rank != "A" {
+		return fmt.Errorf("%w: even money only offered against a dealer ace", ErrNotAllowed)
+	}
+	return nil
+}
+
+func (a EvenMoney) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+	if err := g.validate(ActionEvenMoney, a, p, h); err != nil {
+		return false, err
+	}
+
+	payout := h.Bet*g.Config.Payout + h.Bet
+	p.LocalWallet += payout
+	h.Settled()
+
+	e := store.Event{
+		Type: "EvenMoney",
+		Payload: map[string]any{
+			"PlayerID":    p.ID,
+			"RoundID":     g.RoundId,
+			"HandIndex":   h.Index,
+			"WagerAmount": h.Bet,
+			"Payout":      payout,
+			"LocalWallet": p.LocalWallet,
+		},
+	}
+	g.Store.Append(e)
+	return true, nil
+}
@@ -0,0 +1,45 @@
+package blackjack
+
+import "testing"
+
+func TestEvenMoney(t *testing.T) {
+	g, p := dealAgainstAce(t, []string{"A", "9", "K", "K"})
+	h := p.Hands[0]
+	if _, err := (EvenMoney{}).Execute(g, p, h); err != nil {
+		t.Fatal(err)
+	}
+	if p.LocalWallet != 10010 {
+		t.Fatalf("wallet = %d, want 10010 once even money is paid", p.LocalWallet)
+	}
+
+	// The dealer's blackjack no longer affects the settled hand.
+	g.Dealer.Hand.Status = Blackjack
+	g.State = StateBetsSettle
+	g.Settle()
+	if p.LocalWallet != 10010 {
+		t.Fatalf("wallet = %d after settling, want 10010", p.LocalWallet)
+	}
+}
+
+func TestEvenMoneyNotOffered(t *testing.T) {
+	tests := []struct {
+		name  string
+		ranks []string
+		up    string
+	}{
+		{"no blackjack", []string{"10", "9", "K", "K"}, "A"},
+		{"dealer shows a ten", []string{"A", "9", "K", "K"}, "K"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := dealAgainstAce(t, tt.ranks)
+			g.Dealer.Hand.Cards[0] = card(tt.up)
+			if _, err := (EvenMoney{}).Execute(g, p, p.Hands[0]); err == nil {
+				t.Fatal("even money taken")
+			}
+			if p.LocalWallet != 9990 {
+				t.Fatalf("wallet = %d, want 9990", p.LocalWallet)
+			}
+		})
+	}
+}
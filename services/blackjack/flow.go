@@ -1,6 +1,9 @@
 package blackjack
 
 import (
+	// Standard libs
+	"fmt"
+	// Internal
 	"casino/libs/store"
 )
 
@@ -11,14 +14,24 @@ func (g *Game) StartRound() {
 	g.DoForEachPlayer(func(p *Player) {
 		p.ClearHands()
 	})
+	g.DoForEachBox(func(b *Box) {
+		b.clear()
+	})
+	g.Decisions = nil
 	g.State = StateBetsOpen
 }
 
 //	----- Deal Cards -----
 
 /*
-Cards are dealt in two passes starting with the players.
-After dealing, the dealer and players check for Blackjack.
+Cards are dealt in two passes starting with the players, one hand per box
+in seat order.  Only boxes carrying a main wager are dealt in; see
+wagerComplete.
+After dealing, and once players have switched cards in Blackjack Switch,
+the dealer and players check for Blackjack.
+At no-hole-card tables the dealer is dealt the up card only; in Double
+Exposure both dealer cards are dealt face up.  At shared-hand tables every
+box is dealt the same two cards.
 */
 func (g *Game) DealCards() {
 	if g.State != StateBetsClosed {
@@ -33,37 +46,66 @@ func (g *Game) DealCards() {
 	}
 	g.Store.Append(e)
 
-	g.DoForEachPlayer(func(p *Player) {
-		h := NewHand(p.TotalBet, SplitConfig{})
-		p.AddHand(h)
+	g.DoForEachBox(func(b *Box) {
+		if !g.wagerComplete(b) {
+			g.returnWagers(b)
+			return
+		}
+		h := NewHand(b.Bet, SplitConfig{
+			Index: HandIndex(len(b.Player.Hands)),
+			Seat:  b.Seat,
+		})
+		h.SideBets = append(h.SideBets, b.SideBets...)
+		b.SideBets = nil
+		b.Hand = h
+		// Hands were cleared at the start of the round, so the box is below the limit.
+		_ = b.Player.AddHand(h)
+		b.placeBehind()
 	})
 
+	shared := g.variant().SharedHand
 	for pass := range 2 {
-		g.DoForEachPlayer(func(p *Player) {
-			card := g.Dealer.Shoe.Draw()
-			p.Hands[0].Cards = append(p.Hands[0].Cards, card)
+		if shared {
+			g.fanOut = NewFanOut(g.Dealer.Shoe)
+		}
+		g.DoForEachBoxInPlay(func(b *Box) {
+			g.nextHand()
+			card := g.draw()
+			b.Hand.Cards = append(b.Hand.Cards, card)
+			g.recordDealt(b.Hand, card)
 		})
+		g.fanOut = nil
 		if pass == 0 {
 			g.Dealer.Hand.Cards = append(g.Dealer.Hand.Cards, g.Dealer.Shoe.Draw())
-		} else {
+		} else if !g.Config.Rules.NoHoleCard {
 			card := g.Dealer.Shoe.Draw()
-			card.Hidden = true
+			card.Hidden = !g.variant().DealerExposed
 			g.Dealer.Hand.Cards = append(g.Dealer.Hand.Cards, card)
 		}
 	}
-	g.DoForEachPlayer(func(p *Player) {
-		if p.Hands[0].checkBlackjack() {
-			PrintPlayerHand(p, p.Hands[0])
+	g.resolveSideBets(ResolveAfterDeal)
+	if g.variant().SwitchCards {
+		g.State = StateSwitchTurn
+		g.OfferSwitch()
+	}
+	g.DoForEachBoxInPlay(func(b *Box) {
+		if b.Hand.checkBlackjack() {
+			PrintPlayerHand(b.Player, b.Hand)
 		}
 	})
 	g.dealerPeek()
+	if !g.Config.Rules.NoHoleCard {
+		g.resolveSideBets(ResolveAfterPeek)
+	}
 }
 
 //	----- Dealer Turn -----
 
 /*
 Dealer's turn begins after all player turns are exhausted from the
-games turn queue.
+games turn queue.  The dealer draws to 17, and on a soft 17 when the
+table rules are H17.  The draw is skipped when every player has busted,
+unless a live side bet depends on the dealer's hand.
 */
 func (g *Game) DealerTurn() {
 	if g.State != StateDealerTurn {
@@ -79,9 +121,11 @@ func (g *Game) DealerTurn() {
 	g.Store.Append(e)
 
 	g.Dealer.RevealHoleCard()
+	g.dealSecondCard()
 	PrintDealerHand(g)
-	if !g.AllPlayersBusted() {
-		for g.Dealer.Hand.Value() < 17 {
+	mustPlay := !g.AllPlayersBusted() || g.sideBetsNeedDealerHand()
+	if mustPlay && g.Dealer.Hand.Status != Blackjack {
+		for g.Config.Rules.DealerDraws(g.Dealer.Hand) {
 			card := g.Dealer.Shoe.Draw()
 			g.Dealer.Hand.Cards = append(g.Dealer.Hand.Cards, card)
 			g.Store.Append(store.Event{Type: "DealerHit", Payload: card})
@@ -91,9 +135,25 @@ func (g *Game) DealerTurn() {
 			PrintDealerHand(g)
 		}
 	}
+	g.resolveSideBets(ResolveAfterDealerTurn)
 	g.State = StateBetsSettle
 }
 
+// At no-hole-card tables the dealer completes the initial hand
+// after players act, and only then can have blackjack.
+func (g *Game) dealSecondCard() {
+	if !g.Config.Rules.NoHoleCard || len(g.Dealer.Hand.Cards) != 1 {
+		return
+	}
+	card := g.Dealer.Shoe.Draw()
+	g.Dealer.Hand.Cards = append(g.Dealer.Hand.Cards, card)
+	g.Store.Append(store.Event{Type: "DealerSecondCard", Payload: card})
+	if g.Dealer.Hand.checkBlackjack() {
+		fmt.Println("Dealer has blackjack.")
+	}
+	g.resolveSideBets(ResolveAfterPeek)
+}
+
 // Shuffles the existing shoe.
 func (g *Game) ReshuffleShoe() {
 	if g.State != StateBetsSettle {
@@ -110,19 +170,30 @@ func (g *Game) ReshuffleShoe() {
 			"Message": "Cut card removed - reshuffling.",
 		},
 	})
-	g.Dealer.Shoe.Shuffle(0.65)
+	g.Dealer.Shoe.Shuffle(g.Config.Penetration)
 }
 
 //	----- Shuffle -----
 
 /*
-Creates new decks and shuffles them together into a single shoe.
-See Shoe struct for customizing number of decks and penetration.
+Opens the table, creates new decks and shuffles them together into a single shoe.
+The table configuration is logged on open so the rules of play can be proven later.
+Deck count and penetration come from the game config; the variant may
+build its own decks.
 */
 func (g *Game) Shuffle() {
 	if g.State != StateTableOpen {
 		return
 	}
+
+	g.Store.Append(store.Event{
+		Type: string(g.State),
+		Payload: map[string]any{
+			"Preset": g.Config.Preset,
+			"Config": *g.Config,
+		},
+	})
+
 	g.State = StateShuffleCards
 
 	g.Store.Append(store.Event{
@@ -132,14 +203,12 @@ func (g *Game) Shuffle() {
 		},
 	})
 
-	d1 := NewDeck()
-	d2 := NewDeck()
-	d3 := NewDeck()
-	d4 := NewDeck()
-	d5 := NewDeck()
-	d6 := NewDeck()
+	decks := make([]*Deck, 0, g.Config.Decks)
+	for range g.Config.Decks {
+		decks = append(decks, g.newDeck())
+	}
 
-	g.Dealer.Shoe = NewShoe(0.65, d1, d2, d3, d4, d5, d6)
+	g.Dealer.Shoe = NewShoe(g.Config.Penetration, decks...)
 
 	g.State = StateBetsOpen
 }
@@ -0,0 +1,30 @@
+package blackjack
+
+//	----- Free Bet Blackjack -----
+
+/*
+The house funds doubles on a hard 9, 10 or 11 and splits of every pair
+except tens; the player may still pay to double or split anything else the
+rules allow.  A winning hand is paid on its free stake, but the free stake
+itself is never returned.  A dealer 22 pushes every hand except a blackjack.
+*/
+
+func init() {
+	RegisterVariant(VariantDefinition{
+		Variant: FreeBetBlackjack,
+		FreeStake: func(g *Game, name ActionName, h *Hand) bool {
+			if len(h.Cards) != 2 {
+				return false
+			}
+			switch name {
+			case ActionDouble:
+				v := h.Value()
+				return !h.IsSoft() && v >= 9 && v <= 11
+			case ActionSplit:
+				return !isTenValue(h.Cards[0].Rank)
+			}
+			return false
+		},
+		Outcome: dealer22Pushes,
+	})
+}
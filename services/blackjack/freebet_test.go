@@ -0,0 +1,46 @@
+package blackjack
+
+import "testing"
+
+func TestFreeBetPayouts(t *testing.T) {
+	// 8,8 against a dealer 10/6 is split for free, and both hands are doubled
+	// for free: 8,3 draws a 10 and 8,2 draws a 9.
+	splitAndDouble := []play{{0, Split{}}, {0, Double{}}, {1, Double{}}}
+	tests := []struct {
+		name     string
+		ranks    []string
+		plays    []play
+		freeBets int
+		want     int
+	}{
+		{"free double wins", []string{"6", "10", "5", "6", "9", "10"}, []play{{0, Double{}}}, 10, 10020},
+		{"free double pushes on dealer 22", []string{"6", "10", "5", "6", "9", "6"}, []play{{0, Double{}}}, 10, 10000},
+		{"free split and doubles win", []string{"8", "10", "8", "6", "3", "2", "10", "9", "7"}, splitAndDouble, 30, 10040},
+		{"free split and doubles push on dealer 22", []string{"8", "10", "8", "6", "3", "2", "10", "9", "6"}, splitAndDouble, 30, 10000},
+		{"tens split is paid", []string{"K", "10", "K", "6", "9", "9", "7"}, []play{{0, Split{}}, {0, Stand{}}, {1, Stand{}}}, 0, 10020},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := dealRound(t, "free-bet", 10, tt.ranks)
+			playRound(t, g, p, tt.plays)
+			if p.FreeBets != tt.freeBets {
+				t.Fatalf("free bets = %d, want %d", p.FreeBets, tt.freeBets)
+			}
+			if p.LocalWallet != tt.want {
+				t.Fatalf("wallet = %d, want %d", p.LocalWallet, tt.want)
+			}
+		})
+	}
+}
+
+func TestFreeBetPaidDouble(t *testing.T) {
+	// A soft 17 is not a free double; the player pays for it and wins 20.
+	g, p := dealRound(t, "free-bet", 10, []string{"A", "10", "6", "8", "3"})
+	playRound(t, g, p, []play{{0, Double{}}})
+	if p.FreeBets != 0 {
+		t.Fatalf("free bets = %d, want 0", p.FreeBets)
+	}
+	if p.LocalWallet != 10020 {
+		t.Fatalf("wallet = %d, want 10020", p.LocalWallet)
+	}
+}
@@ -1,6 +1,9 @@
 package blackjack
 
 import (
+	// Standard libs
+	"sort"
+	// Internal
 	"casino/libs/fsm"
 	"casino/libs/store"
 )
@@ -14,16 +17,22 @@ Players should not manipulate the deck or state directly; they should “request
 This prevents illegal moves and keeps the event log consistent.
 */
 type Game struct {
-	State               fsm.State
-	Seat1, Seat2, Seat3 *Player
-	Dealer              *Dealer
-	TurnQueue           []Turn
-	Store               *store.EventStore
-	Config              *GameConfig
-	RoundId             int
+	State       fsm.State
+	Seats       []*Box // Boxes in dealing order; empty seats are nil
+	Dealer      *Dealer
+	TurnQueue   []Turn
+	Store       *store.EventStore
+	Config      *GameConfig
+	RoundId     int
+	Progressive *Progressive // Shared jackpot, nil when the table is not linked
+	Decisions   []Decision   // Choices made at the current decision point of a shared-hand table
+	fanOut      *FanOut      // Open while a shared deal or decision point is being dealt
 }
 
 type GameConfig struct {
+	Preset          string
+	Variant         Variant // Game played at the table; empty plays standard blackjack
+	Seats           int     // Number of boxes, 1 to MaxSeats
 	MinBuyIn        int
 	MaxBuyIn        int
 	MinWager        int
@@ -31,27 +40,48 @@ type GameConfig struct {
 	Payout          int
 	InsurancePayout float64 // 2.0 = 2:1
 	BlackjackPayout float64 // 1.5 = 3:2
+	Decks           int
+	Penetration     float64 // 0.65 = 65% of the shoe dealt before reshuffle
+	MinSideBet      int
+	MaxSideBet      int
+	SideBets        map[SideBetType]Paytable // Side bets offered; a nil paytable uses the default
+	Rules           Rules
 }
 
+// Returns the side bets offered at the table in a stable order.
+func (c *GameConfig) OfferedSideBets() []SideBetType {
+	types := make([]SideBetType, 0, len(c.SideBets))
+	for t := range c.SideBets {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// Creates a game using the default table preset.
 func NewGame(store *store.EventStore) *Game {
+	cfg, _ := LoadPreset(DefaultPreset)
+	return newGame(store, cfg)
+}
+
+// Creates a game from a named table preset, with optional overrides.
+func NewGameFromPreset(store *store.EventStore, preset string, overrides ...ConfigOverride) (*Game, error) {
+	cfg, err := LoadPreset(preset, overrides...)
+	if err != nil {
+		return nil, err
+	}
+	return newGame(store, cfg), nil
+}
+
+func newGame(store *store.EventStore, cfg *GameConfig) *Game {
 	d := NewDealer("Dealer")
 	return &Game{
 		State:     StateTableOpen,
-		Seat1:     nil,
-		Seat2:     nil,
-		Seat3:     nil,
+		Seats:     make([]*Box, cfg.Seats),
 		Dealer:    d,
 		TurnQueue: []Turn{},
 		Store:     store,
-		Config: &GameConfig{
-			MinBuyIn:        100,
-			MaxBuyIn:        100000,
-			MinWager:        5,
-			MaxWager:        10000,
-			Payout:          1,
-			InsurancePayout: 2.0,
-			BlackjackPayout: 1.5,
-		},
-		RoundId: 0,
+		Config:    cfg,
+		RoundId:   0,
 	}
 }
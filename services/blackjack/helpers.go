@@ -1,27 +1,49 @@
 package blackjack
 
-// Executes a callback function for each player at the table.
+// Executes a callback function once for each player at the table,
+// in the order of their first box.
 func (g *Game) DoForEachPlayer(fn func(*Player)) {
-	for _, p := range g.GetSeats() {
-		if p != nil {
-			fn(p)
+	seen := make(map[*Player]bool)
+	for _, b := range g.GetSeats() {
+		if b == nil || seen[b.Player] {
+			continue
+		}
+		seen[b.Player] = true
+		fn(b.Player)
+	}
+}
+
+// Executes a callback function for each occupied box in dealing order.
+func (g *Game) DoForEachBox(fn func(*Box)) {
+	for _, b := range g.GetSeats() {
+		if b != nil {
+			fn(b)
 		}
 	}
 }
 
+// Executes a callback function for each box dealt into the round, in dealing order.
+func (g *Game) DoForEachBoxInPlay(fn func(*Box)) {
+	g.DoForEachBox(func(b *Box) {
+		if b.Hand != nil {
+			fn(b)
+		}
+	})
+}
+
 // Returns the seats in dealing order, including nils.
-func (g *Game) GetSeats() []*Player {
-	return []*Player{g.Seat1, g.Seat2, g.Seat3}
+func (g *Game) GetSeats() []*Box {
+	return g.Seats
 }
 
-// Returns the of most recent unpaid side bet of a given type, otherwise returns nil.
-func latestUnpaidSideBet(bets []*SideBet, t SideBetType) *SideBet {
+// Returns the most recent unresolved side bet of a given type, otherwise returns nil.
+func latestOpenSideBet(bets []*SideBet, t SideBetType) *SideBet {
 	for i := len(bets) - 1; i >= 0; i-- {
 		sb := bets[i]
 		if sb == nil {
 			continue
 		}
-		if sb.Type == t && !sb.Paid {
+		if sb.Type == t && !sb.Resolved {
 			return sb
 		}
 	}
@@ -30,15 +52,13 @@ func latestUnpaidSideBet(bets []*SideBet, t SideBetType) *SideBet {
 
 // Returns true if all players have busted.
 func (g *Game) AllPlayersBusted() bool {
-	for _, p := range g.GetSeats() {
-		if p == nil {
-			continue
-		}
+	busted := true
+	g.DoForEachPlayer(func(p *Player) {
 		for _, h := range p.Hands {
 			if h.Status != Busted {
-				return false
+				busted = false
 			}
 		}
-	}
-	return true
+	})
+	return busted
 }
@@ -0,0 +1,111 @@
+package blackjack
+
+import (
+	// Standard libs
+	"testing"
+	// Internal
+	"casino/libs/store"
+)
+
+// Returns a heart of the given rank.
+func card(rank string) Card {
+	return Card{Suit: "Hearts", Rank: rank}
+}
+
+// Returns a card of the given rank and suit.
+func suited(rank, suit string) Card {
+	return Card{Suit: suit, Rank: rank}
+}
+
+// Returns a hand of hearts of the given ranks.
+func hand(ranks ...string) *Hand {
+	h := NewHand(0, SplitConfig{})
+	for _, r := range ranks {
+		h.Cards = append(h.Cards, card(r))
+	}
+	return h
+}
+
+// Returns a shoe that deals hearts of the given ranks in order.
+func stackedShoe(ranks ...string) *Shoe {
+	cards := make([]Card, 0, len(ranks))
+	for _, r := range ranks {
+		cards = append(cards, card(r))
+	}
+	return &Shoe{cards: cards, cutIndex: len(cards) + 1}
+}
+
+// Opens a table from a preset with one player in the first seat and stacks
+// the shoe to deal the given ranks in order.  The player's bet is placed and
+// betting is still open.
+func openRound(t *testing.T, preset string, bet int, ranks []string, overrides ...ConfigOverride) (*Game, *Player) {
+	t.Helper()
+	g, err := NewGameFromPreset(store.NewEventStore(), preset, overrides...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewPlayer("1", "Tester")
+	seat, err := g.JoinTable(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.Dealer.Shoe = stackedShoe(ranks...)
+
+	g.State = StateBetsOpen
+	if err := g.PlaceBet(seat, bet); err != nil {
+		t.Fatal(err)
+	}
+	return g, p
+}
+
+// Closes betting and deals.  While players are taking turns the player's
+// hand is queued to act.
+func deal(g *Game, p *Player) {
+	g.State = StateBetsClosed
+	g.DealCards()
+	if g.State == StatePlayerTurn {
+		g.Enqueue(Turn{Player: p, Hand: p.Hands[0]})
+	}
+}
+
+// Opens a table and deals a round; see openRound.
+func dealRound(t *testing.T, preset string, bet int, ranks []string, overrides ...ConfigOverride) (*Game, *Player) {
+	t.Helper()
+	g, p := openRound(t, preset, bet, ranks, overrides...)
+	deal(g, p)
+	return g, p
+}
+
+// Deals a round on a bet of 10 and turns the dealer's up card to an ace,
+// as the insurance round opens.
+func dealAgainstAce(t *testing.T, ranks []string) (*Game, *Player) {
+	t.Helper()
+	g, p := dealRound(t, DefaultPreset, 10, ranks)
+	g.Dealer.Hand.Cards[0] = card("A")
+	g.State = StateInsuranceTurn
+	return g, p
+}
+
+// A player action on one of the player's hands.
+type play struct {
+	hand   int
+	action Action
+}
+
+// Plays the actions, ending turns as they complete, then plays the dealer's
+// turn and settles.
+func playRound(t *testing.T, g *Game, p *Player, plays []play) {
+	t.Helper()
+	for _, pl := range plays {
+		endTurn, err := pl.action.Execute(g, p, p.Hands[pl.hand])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if endTurn {
+			g.AdvanceTurn()
+		}
+	}
+	g.State = StateDealerTurn
+	g.DealerTurn()
+	g.Settle()
+}
@@ -16,17 +16,40 @@ continue to HIT if the total hand value is <= 21.
 */
 type Hit struct{}
 
-func (Hit) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+func (Hit) Validate(g *Game, p *Player, h *Hand) error {
 	if g.State != StatePlayerTurn {
-		return false, fmt.Errorf("cannot hit while in %s", g.State)
+		return &StateError{Action: "hit", State: g.State}
 	}
 
 	if h.Status == Busted {
-		return false, fmt.Errorf("hit not applicable; player busted")
+		return fmt.Errorf("%w: player busted", ErrHandNotInPlay)
 	}
 
-	card := g.Dealer.Shoe.Draw()
+	if h.Status != Qualified {
+		return ErrHandNotInPlay
+	}
+
+	if h.Stood {
+		return fmt.Errorf("%w: hand already stands", ErrHandNotInPlay)
+	}
+	if h.DoubleDown {
+		return fmt.Errorf("%w: cannot hit a doubled hand", ErrNotAllowed)
+	}
+
+	if h.IsSplitAces() && len(h.Cards) >= 2 && !g.Config.Rules.HitSplitAces {
+		return fmt.Errorf("%w: cannot hit split aces", ErrNotAllowed)
+	}
+	return nil
+}
+
+func (a Hit) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+	if err := g.validate(ActionHit, a, p, h); err != nil {
+		return false, err
+	}
+
+	card := g.draw()
 	h.Cards = append(h.Cards, card)
+	g.recordDealt(h, card)
 
 	e := store.Event{
 		Type: "Hit",
@@ -7,31 +7,103 @@ import (
 	"casino/libs/store"
 )
 
+// Tier paid when the dealer has blackjack.
+const InsuranceDealerBlackjack = "DEALER_BLACKJACK"
+
+func init() {
+	RegisterSideBet(SideBetDefinition{
+		Type:    InsuranceBet,
+		Window:  StateInsuranceTurn,
+		Trigger: ResolveAfterDealerTurn,
+		Paytable: func(cfg *GameConfig) Paytable {
+			return Paytable{InsuranceDealerBlackjack: cfg.InsurancePayout}
+		},
+		Resolve: func(g *Game, p *Player, h *Hand) string {
+			if g.Dealer.Hand.Status == Blackjack {
+				return InsuranceDealerBlackjack
+			}
+			return ""
+		},
+	})
+}
+
 /*
-The player places an additional bet equal to half their original stake.
+The player places an additional bet of up to half their original stake.
 Available only when the dealer up card is an Ace.
 On dealer Blackjack, insurance pays 2:1 and the original stake is lost.
 Otherwise, the insurance bet is lost and play resumes as normal.
 Offered after all cards are dealt and before the first player action.
+Blackjack hands are offered even money instead.
+An Amount of zero insures for the full half stake.
 */
-type Insurance struct{}
+type Insurance struct {
+	Amount int
+}
 
-func (Insurance) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+func (i Insurance) Validate(g *Game, p *Player, h *Hand) error {
 	if g.State != StateInsuranceTurn {
-		return false, fmt.Errorf("cannot accept insurance while in %s", g.State)
+		return &StateError{Action: "accept insurance", State: g.State}
 	}
 
-	insuranceBetAmount := h.Bet / 2
-	p.Wager(insuranceBetAmount)
+	if h.Status == Blackjack {
+		return fmt.Errorf("%w: cannot insure blackjack; take even money instead", ErrNotAllowed)
+	}
+
+	if h.Status != Qualified {
+		return ErrHandNotInPlay
+	}
+
+	if latestOpenSideBet(h.SideBets, InsuranceBet) != nil {
+		return fmt.Errorf("%w: hand is already insured", ErrNotAllowed)
+	}
+
+	maxAmount := insuranceLimit(g, h)
+	amount := i.amount(g, h)
+	if err := checkAmount("insurance", amount, 1, maxAmount); err != nil {
+		return err
+	}
+	return checkFunds(p, amount)
+}
+
+// Returns the most a hand may be insured for: half the bet, within the table maximum.
+func insuranceLimit(g *Game, h *Hand) int {
+	maxAmount := h.Bet / 2
+	if maxAmount > g.Config.MaxWager {
+		maxAmount = g.Config.MaxWager
+	}
+	return maxAmount
+}
+
+// Returns the insurance stake; zero insures for the full limit.
+func (i Insurance) amount(g *Game, h *Hand) int {
+	if i.Amount == 0 {
+		return insuranceLimit(g, h)
+	}
+	return i.Amount
+}
+
+func (i Insurance) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+	if err := g.validate(ActionInsurance, i, p, h); err != nil {
+		return false, err
+	}
+
+	maxAmount := insuranceLimit(g, h)
+	insuranceBetAmount := i.amount(g, h)
+
+	if err := g.takeWager(p, insuranceBetAmount); err != nil {
+		return false, err
+	}
 	insuranceBet := NewSideBet(InsuranceBet, insuranceBetAmount)
 	h.SideBets = append(h.SideBets, insuranceBet)
 
 	e := store.Event{
 		Type: "Insurance",
 		Payload: map[string]any{
-			"PlayerID": p.ID,
-			"Insured":  true,
-			"Amount":   insuranceBetAmount,
+			"PlayerID":  p.ID,
+			"Insured":   true,
+			"Amount":    insuranceBetAmount,
+			"MaxAmount": maxAmount,
+			"Partial":   insuranceBetAmount < h.Bet/2,
 		},
 	}
 
@@ -0,0 +1,63 @@
+package blackjack
+
+import (
+	// Standard libs
+	"errors"
+	"testing"
+)
+
+func TestInsuranceLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		ranks   []string
+		actions []Insurance
+		want    error
+		insured int // Total insurance taken on the hand
+	}{
+		{"full half stake", []string{"10", "9", "8", "7"}, []Insurance{{}}, nil, 5},
+		{"partial", []string{"10", "9", "8", "7"}, []Insurance{{Amount: 3}}, nil, 3},
+		{"over half stake", []string{"10", "9", "8", "7"}, []Insurance{{Amount: 6}}, ErrInvalidAmount, 0},
+		{"already insured", []string{"10", "9", "8", "7"}, []Insurance{{Amount: 3}, {Amount: 2}}, ErrNotAllowed, 3},
+		{"blackjack", []string{"A", "9", "K", "7"}, []Insurance{{}}, ErrNotAllowed, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := dealAgainstAce(t, tt.ranks)
+			h := p.Hands[0]
+			var err error
+			for _, a := range tt.actions {
+				_, err = a.Execute(g, p, h)
+			}
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			insured := 0
+			for _, sb := range h.SideBets {
+				if sb.Type == InsuranceBet {
+					insured += sb.Amount
+				}
+			}
+			if insured != tt.insured {
+				t.Fatalf("insured = %d, want %d", insured, tt.insured)
+			}
+			if want := 9990 - tt.insured; p.LocalWallet != want {
+				t.Fatalf("wallet = %d, want %d", p.LocalWallet, want)
+			}
+		})
+	}
+}
+
+func TestInsurancePays(t *testing.T) {
+	g, p := dealAgainstAce(t, []string{"10", "9", "8", "7"})
+	if _, err := (Insurance{Amount: 4}).Execute(g, p, p.Hands[0]); err != nil {
+		t.Fatal(err)
+	}
+	// Insurance pays 2:1 against the dealer's blackjack; the main bet is lost.
+	g.Dealer.Hand.Cards[1] = card("K")
+	g.Dealer.Hand.Status = Blackjack
+	g.State = StateBetsSettle
+	g.Settle()
+	if p.LocalWallet != 9998 {
+		t.Fatalf("wallet = %d, want 9998", p.LocalWallet)
+	}
+}
@@ -0,0 +1,65 @@
+package blackjack
+
+//	----- Lucky Ladies -----
+
+/*
+Pays when the player's first two cards total 20.
+Tiers rise from any 20 through suited and matched 20s to a pair of
+Queens of Hearts, with the top tier paid only when the dealer also has
+blackjack.  Resolved once the dealer's blackjack is known, against the
+cards as dealt even if the hand has since been split.
+*/
+
+const (
+	AnyTwenty             = "ANY_20"
+	SuitedTwenty          = "SUITED_20"
+	MatchedTwenty         = "MATCHED_20"
+	QueenOfHeartsPair     = "QUEEN_OF_HEARTS_PAIR"
+	QueenOfHeartsDealerBJ = "QUEEN_OF_HEARTS_PAIR_DEALER_BLACKJACK"
+)
+
+func init() {
+	RegisterSideBet(SideBetDefinition{
+		Type:    LuckyLadyBet,
+		Window:  StateBetsOpen,
+		Trigger: ResolveAfterPeek,
+		Paytable: func(cfg *GameConfig) Paytable {
+			return Paytable{
+				AnyTwenty:             4,
+				SuitedTwenty:          10,
+				MatchedTwenty:         25,
+				QueenOfHeartsPair:     200,
+				QueenOfHeartsDealerBJ: 1000,
+			}
+		},
+		Resolve: func(g *Game, p *Player, h *Hand) string {
+			dealt := g.dealtCards(h)
+			if len(dealt) < 2 {
+				return ""
+			}
+			first := Hand{Cards: dealt[:2]}
+			return luckyLadiesTier(first, g.Dealer.Hand.Status == Blackjack)
+		},
+	})
+}
+
+// Returns the Lucky Ladies tier for a two card hand, or an empty string.
+func luckyLadiesTier(h Hand, dealerBlackjack bool) string {
+	if h.Value() != 20 {
+		return ""
+	}
+	c1, c2 := h.Cards[0], h.Cards[1]
+	queenOfHearts := c1.Rank == "Q" && c1.Suit == "Hearts" && c2.Rank == "Q" && c2.Suit == "Hearts"
+	switch {
+	case queenOfHearts && dealerBlackjack:
+		return QueenOfHeartsDealerBJ
+	case queenOfHearts:
+		return QueenOfHeartsPair
+	case c1.Rank == c2.Rank && c1.Suit == c2.Suit:
+		return MatchedTwenty
+	case c1.Suit == c2.Suit:
+		return SuitedTwenty
+	default:
+		return AnyTwenty
+	}
+}
@@ -0,0 +1,48 @@
+package blackjack
+
+import "testing"
+
+func TestLuckyLadiesTier(t *testing.T) {
+	tests := []struct {
+		name     string
+		cards    []Card
+		dealerBJ bool
+		want     string
+	}{
+		{"19", []Card{suited("10", "Hearts"), suited("9", "Spades")}, false, ""},
+		{"any 20", []Card{suited("10", "Hearts"), suited("K", "Spades")}, false, AnyTwenty},
+		{"suited 20", []Card{suited("10", "Clubs"), suited("K", "Clubs")}, false, SuitedTwenty},
+		{"matched 20", []Card{suited("J", "Clubs"), suited("J", "Clubs")}, false, MatchedTwenty},
+		{"queens of hearts", []Card{suited("Q", "Hearts"), suited("Q", "Hearts")}, false, QueenOfHeartsPair},
+		{"queens of hearts and dealer blackjack", []Card{suited("Q", "Hearts"), suited("Q", "Hearts")}, true, QueenOfHeartsDealerBJ},
+		{"soft 20", []Card{suited("A", "Clubs"), suited("9", "Clubs")}, false, SuitedTwenty},
+	}
+	for _, tt := range tests {
+		if got := luckyLadiesTier(Hand{Cards: tt.cards}, tt.dealerBJ); got != tt.want {
+			t.Errorf("%s: tier = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLuckyLadiesAfterSplit(t *testing.T) {
+	offer := func(c *GameConfig) {
+		c.SideBets = map[SideBetType]Paytable{LuckyLadyBet: nil}
+	}
+	// Without a hole card the bet resolves after the dealer's turn, by which
+	// time the matched K,K has been split into K,5 and K,6.
+	g, p := openRound(t, "european", 10, []string{"K", "9", "K", "5", "6", "8"}, offer)
+	if _, err := (PlaceSideBet{Type: LuckyLadyBet, Amount: 5}).Execute(g, p, nil); err != nil {
+		t.Fatal(err)
+	}
+	deal(g, p)
+	playRound(t, g, p, []play{{0, Split{}}, {0, Stand{}}, {1, Stand{}}})
+
+	sb := p.Hands[0].SideBets[0]
+	if sb.Tier != MatchedTwenty {
+		t.Fatalf("tier = %q, want %q", sb.Tier, MatchedTwenty)
+	}
+	// Both split hands lose to the dealer's 17.
+	if want := 9975 + 5*25 + 5; p.LocalWallet != want {
+		t.Fatalf("wallet = %d, want %d", p.LocalWallet, want)
+	}
+}
@@ -0,0 +1,55 @@
+package blackjack
+
+//	----- Perfect Pairs -----
+
+/*
+Pays when the player's first two cards are a pair.
+A mixed pair differs in colour, a coloured pair shares a colour but not a suit,
+and a perfect pair is the same rank and suit.
+Placed while bets are open and resolved as soon as the cards are dealt.
+*/
+
+const (
+	MixedPair   = "MIXED_PAIR"
+	ColoredPair = "COLORED_PAIR"
+	PerfectPair = "PERFECT_PAIR"
+)
+
+func init() {
+	RegisterSideBet(SideBetDefinition{
+		Type:    PairBet,
+		Window:  StateBetsOpen,
+		Trigger: ResolveAfterDeal,
+		Paytable: func(cfg *GameConfig) Paytable {
+			return Paytable{
+				MixedPair:   5,
+				ColoredPair: 10,
+				PerfectPair: 25,
+			}
+		},
+		Resolve: func(g *Game, p *Player, h *Hand) string {
+			return perfectPairsTier(h.Cards)
+		},
+	})
+}
+
+// Returns the Perfect Pairs tier of the first two cards, or an empty string.
+func perfectPairsTier(cards []Card) string {
+	if len(cards) < 2 || cards[0].Rank != cards[1].Rank {
+		return ""
+	}
+	c1, c2 := cards[0], cards[1]
+	switch {
+	case c1.Suit == c2.Suit:
+		return PerfectPair
+	case isRed(c1.Suit) == isRed(c2.Suit):
+		return ColoredPair
+	default:
+		return MixedPair
+	}
+}
+
+// Returns true for hearts and diamonds.
+func isRed(suit string) bool {
+	return suit == "Hearts" || suit == "Diamonds"
+}
@@ -0,0 +1,37 @@
+package blackjack
+
+import "testing"
+
+func TestPerfectPairsTier(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []Card
+		want  string
+	}{
+		{"no pair", []Card{suited("8", "Hearts"), suited("9", "Hearts")}, ""},
+		{"mixed", []Card{suited("8", "Hearts"), suited("8", "Spades")}, MixedPair},
+		{"coloured", []Card{suited("8", "Hearts"), suited("8", "Diamonds")}, ColoredPair},
+		{"perfect", []Card{suited("8", "Clubs"), suited("8", "Clubs")}, PerfectPair},
+		{"tens of different rank", []Card{suited("10", "Clubs"), suited("K", "Clubs")}, ""},
+	}
+	for _, tt := range tests {
+		if got := perfectPairsTier(tt.cards); got != tt.want {
+			t.Errorf("%s: tier = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPerfectPairsPays(t *testing.T) {
+	offer := func(c *GameConfig) {
+		c.SideBets = map[SideBetType]Paytable{PairBet: {PerfectPair: 30}}
+	}
+	g, p := openRound(t, DefaultPreset, 10, []string{"8", "9", "8", "7"}, offer)
+	if _, err := (PlaceSideBet{Type: PairBet, Amount: 5}).Execute(g, p, nil); err != nil {
+		t.Fatal(err)
+	}
+	deal(g, p)
+	// The table's paytable replaces the default 25:1.
+	if want := 9985 + 5*30 + 5; p.LocalWallet != want {
+		t.Fatalf("wallet = %d, want %d", p.LocalWallet, want)
+	}
+}
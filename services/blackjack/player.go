@@ -5,6 +5,7 @@ import (
 	"strconv"
 )
 
+// Engine ceiling on hands per box; Rules.MaxSplits narrows it per table.
 const MaxHandsPerPlayer = 4
 
 //	----- Player Structures -----
@@ -12,10 +13,11 @@ const MaxHandsPerPlayer = 4
 type Player struct {
 	ID           string
 	Name         string
-	Hands        []*Hand
-	TotalBet     int
-	LocalWallet  int // Bankroll for each game session
-	GlobalWallet int // Wallet that persists across game sessions
+	Hands        []*Hand // Hands across all of the player's boxes
+	TotalBet     int     // Stake funded by the player this round
+	FreeBets     int     // Stake funded by the house this round
+	LocalWallet  int     // Bankroll for each game session
+	GlobalWallet int     // Wallet that persists across game sessions
 	Status       PlayerStatus
 }
 
@@ -47,36 +49,63 @@ func (p *Player) ClearHands() {
 	}
 	p.Hands = p.Hands[:0]
 	p.TotalBet = 0
+	p.FreeBets = 0
 }
 
 // Wager checks to ensure the Player has the funds to make a bet
 // and updates the players total bet and local wallet.  Ensure
 // to update the Hand's bet property elsewhere.
-func (p *Player) Wager(bet int) {
-	if bet > p.LocalWallet {
-		fmt.Print("not enough funds in local wallet")
+func (p *Player) Wager(bet int) error {
+	if err := checkFunds(p, bet); err != nil {
+		return err
 	}
 	p.TotalBet += bet
 	p.LocalWallet -= bet
+	return nil
 }
 
 // Add hand to the players collection.
-func (p *Player) AddHand(h *Hand) {
-	if len(p.Hands) >= MaxHandsPerPlayer {
-		fmt.Println("Cannot add more hands: maximum hands per player reached.")
-		return
+func (p *Player) AddHand(h *Hand) error {
+	if p.HandsInSeat(h.Seat) >= MaxHandsPerPlayer {
+		return fmt.Errorf("%w: seat %d holds %d hands", ErrMaxHands, h.Seat, MaxHandsPerPlayer)
 	}
 	p.Hands = append(p.Hands, h)
+	return nil
 }
 
-// Check that the Player is elligble for SPLIT
-func (player *Player) CanSplit(hand *Hand) (bool, error) {
-	if len(player.Hands) >= MaxHandsPerPlayer {
-		return false, fmt.Errorf("cannot split; player has maximum number of hands")
+// Returns the player's hand with the given index, otherwise returns nil.
+func (p *Player) HandAt(idx HandIndex) *Hand {
+	for _, h := range p.Hands {
+		if h.Index == idx {
+			return h
+		}
+	}
+	return nil
+}
+
+// Returns the number of hands the player holds in a box.
+func (p *Player) HandsInSeat(seat int) int {
+	n := 0
+	for _, h := range p.Hands {
+		if h.Seat == seat {
+			n++
+		}
+	}
+	return n
+}
+
+// Check that the Player is elligble for SPLIT under the table rules.
+func (player *Player) CanSplit(hand *Hand, rules Rules) (bool, error) {
+	if player.HandsInSeat(hand.Seat) >= rules.MaxHands() {
+		return false, fmt.Errorf("%w: cannot split", ErrMaxHands)
 	}
 
 	if !hand.IsFirstAction() {
-		return false, fmt.Errorf("cannot split; player can only split on first action of hand")
+		return false, fmt.Errorf("%w: split", ErrNotFirstAction)
+	}
+
+	if hand.IsSplitAces() && !rules.ResplitAces {
+		return false, fmt.Errorf("%w: resplitting aces", ErrNotAllowed)
 	}
 
 	c1 := hand.Cards[0].Rank
@@ -86,39 +115,49 @@ func (player *Player) CanSplit(hand *Hand) (bool, error) {
 		return true, nil
 	}
 
-	var match bool
-	isValueTen := map[string]bool{
-		"10": true,
-		"J":  true,
-		"Q":  true,
-		"K":  true,
-	}
-
-	match = isValueTen[c1] && isValueTen[c2]
+	match := isTenValue(c1) && isTenValue(c2)
 
 	if !match {
-		return match, fmt.Errorf("cannot split; cards are not same value")
+		return match, fmt.Errorf("%w: cannot split cards that are not the same value", ErrNotAllowed)
 	}
 
 	return match, nil
 }
 
+// Returns true for 10, J, Q and K.
+func isTenValue(rank string) bool {
+	switch rank {
+	case "10", "J", "Q", "K":
+		return true
+	}
+	return false
+}
+
 //	----- Hand Structures -----
 
 // Represents a collection of cards held by a Player or Dealer.
 type Hand struct {
 	Index      HandIndex
+	Seat       int // Box the hand is played from
 	Cards      []Card
 	Status     HandStatus
-	Bet        int
+	Bet        int // Stake funded by the player
+	FreeBet    int // Stake funded by the house; wins are paid but the stake is not returned
 	SideBets   []*SideBet
 	DoubleDown bool
-	IsSplit    bool
+	// Additional stake placed on a double, at most the original bet.
+	DoubleAmount int
+	IsSplit      bool
+	Stood        bool // Stood by the player or automatically on split aces
+	Switched     bool // Second card swapped with the player's other box
+	// SurrenderLate or SurrenderEarly once the hand is surrendered.
+	SurrenderedAs SurrenderMode
 }
 
 func NewHand(bet int, opts SplitConfig) *Hand {
 	return &Hand{
 		Index:      opts.Index,
+		Seat:       opts.Seat,
 		Cards:      opts.Cards,
 		Status:     Qualified,
 		Bet:        bet,
@@ -129,11 +168,16 @@ func NewHand(bet int, opts SplitConfig) *Hand {
 
 type SplitConfig struct {
 	Index   HandIndex
+	Seat    int
 	Cards   []Card
 	IsSplit bool
 }
 
 type HandIndex int
+
+// Passed in place of a hand index for actions taken before cards are dealt.
+const NoHand HandIndex = -1
+
 type HandStatus int
 
 const (
@@ -150,9 +194,9 @@ func (h *Hand) Blackjack() { h.Status = Blackjack }
 func (h *Hand) Surrender() { h.Status = Surrendered }
 func (h *Hand) Settled()   { h.Status = Settled }
 
-// Caclulates the hand total.
+// Caclulates the hand total and whether an ace is still counted as 11.
 // If includeHidden is false, hidden cards are ignored.
-func (h Hand) valueCore(includeHidden bool) int {
+func (h Hand) valueCore(includeHidden bool) (int, bool) {
 	total, aces := 0, 0
 
 	for _, c := range h.Cards {
@@ -177,28 +221,59 @@ func (h Hand) valueCore(includeHidden bool) int {
 		total -= 10
 		aces--
 	}
-	return total
+	return total, aces > 0
 }
 
 // Returns the total of visible cards only.
-func (h Hand) Value() int { return h.valueCore(false) }
+func (h Hand) Value() int {
+	total, _ := h.valueCore(false)
+	return total
+}
 
 // Returns the total including hidden cards.
-func (h Hand) ValueAll() int { return h.valueCore(true) }
+func (h Hand) ValueAll() int {
+	total, _ := h.valueCore(true)
+	return total
+}
+
+// Returns true if the visible total counts an ace as 11.
+func (h Hand) IsSoft() bool {
+	_, soft := h.valueCore(false)
+	return soft
+}
+
+// Returns true if the hand was formed by splitting a pair of aces.
+func (h Hand) IsSplitAces() bool {
+	return h.IsSplit && len(h.Cards) > 0 && h.Cards[0].Rank == "A"
+}
 
 // Check for players blackjack.
+// A split hand totalling 21 is never blackjack and pays even money.
 func (h *Hand) checkBlackjack() bool {
-	if h.Value() == 21 && len(h.Cards) == 2 && !h.IsSplit {
+	if h.Value() == 21 && len(h.Cards) == 2 && !h.IsSplit && !h.Switched {
 		h.Blackjack()
 		return true
 	}
 	return false
 }
 
+// Returns the whole stake riding the hand, free stake included.
+func (h Hand) Stake() int {
+	return h.Bet + h.FreeBet
+}
+
 // Check that the hand is elligble for actions such as Double or Split.
 func (h Hand) IsFirstAction() bool {
-	if len(h.Cards) != 2 || h.Status != Qualified {
+	if len(h.Cards) != 2 || h.Status != Qualified || h.Stood {
 		return false
 	}
 	return true
 }
+
+// Check that the hand may be doubled, on any number of cards when the rules allow it.
+func (h Hand) CanDouble(rules Rules) bool {
+	if !rules.DoubleAnyCards {
+		return h.IsFirstAction()
+	}
+	return len(h.Cards) >= 2 && h.Status == Qualified && !h.Stood && !h.DoubleDown
+}
@@ -0,0 +1,338 @@
+package blackjack
+
+import (
+	// Standard libs
+	"fmt"
+	"maps"
+	"sort"
+)
+
+//	----- Table Presets -----
+
+/*
+Named table configurations so a game can be opened as e.g. "atlantic-city"
+rather than hand-assembling limits, payouts, shoe size and rules.
+Presets are copied on load; overrides never mutate the registry.
+*/
+
+const DefaultPreset = "classic"
+
+// Adjusts a loaded preset field-by-field before the game is created.
+type ConfigOverride func(*GameConfig)
+
+var presets = map[string]GameConfig{
+	DefaultPreset: {
+		Seats:           7,
+		MinBuyIn:        100,
+		MaxBuyIn:        100000,
+		MinWager:        5,
+		MaxWager:        10000,
+		Payout:          1,
+		InsurancePayout: 2.0,
+		BlackjackPayout: 1.5,
+		Decks:           6,
+		Penetration:     0.65,
+		MinSideBet:      1,
+		MaxSideBet:      500,
+		Rules:           DefaultRules(),
+	},
+	"vegas-strip-6d": {
+		Seats:           7,
+		MinBuyIn:        500,
+		MaxBuyIn:        250000,
+		MinWager:        25,
+		MaxWager:        25000,
+		Payout:          1,
+		InsurancePayout: 2.0,
+		BlackjackPayout: 1.5,
+		Decks:           6,
+		Penetration:     0.75,
+		MinSideBet:      5,
+		MaxSideBet:      1000,
+		SideBets: map[SideBetType]Paytable{
+			PairBet:       nil,
+			TwentyOne3Bet: nil,
+		},
+		Rules: Rules{
+			DealerHitsSoft17: false,
+			DoubleAfterSplit: true,
+			DoubleOn:         DoubleAnyTwo,
+			MaxSplits:        3,
+			ResplitAces:      false,
+			HitSplitAces:     false,
+			Surrender:        SurrenderLate,
+		},
+	},
+	"downtown": {
+		Seats:           7,
+		MinBuyIn:        100,
+		MaxBuyIn:        50000,
+		MinWager:        5,
+		MaxWager:        5000,
+		Payout:          1,
+		InsurancePayout: 2.0,
+		BlackjackPayout: 1.5,
+		Decks:           2,
+		Penetration:     0.65,
+		MinSideBet:      1,
+		MaxSideBet:      100,
+		Rules: Rules{
+			DealerHitsSoft17: true,
+			DoubleAfterSplit: true,
+			DoubleOn:         DoubleAnyTwo,
+			MaxSplits:        3,
+			ResplitAces:      false,
+			HitSplitAces:     false,
+			Surrender:        SurrenderNone,
+		},
+	},
+	"atlantic-city": {
+		Seats:           7,
+		MinBuyIn:        200,
+		MaxBuyIn:        100000,
+		MinWager:        15,
+		MaxWager:        10000,
+		Payout:          1,
+		InsurancePayout: 2.0,
+		BlackjackPayout: 1.5,
+		Decks:           8,
+		Penetration:     0.75,
+		MinSideBet:      5,
+		MaxSideBet:      500,
+		SideBets: map[SideBetType]Paytable{
+			TwentyOne3Bet: nil,
+		},
+		Rules: Rules{
+			DealerHitsSoft17: false,
+			DoubleAfterSplit: true,
+			DoubleOn:         DoubleAnyTwo,
+			MaxSplits:        2,
+			ResplitAces:      false,
+			HitSplitAces:     false,
+			Surrender:        SurrenderLate,
+		},
+	},
+	"european": {
+		Seats:           7,
+		MinBuyIn:        100,
+		MaxBuyIn:        50000,
+		MinWager:        10,
+		MaxWager:        5000,
+		Payout:          1,
+		InsurancePayout: 2.0,
+		BlackjackPayout: 1.5,
+		Decks:           6,
+		Penetration:     0.70,
+		MinSideBet:      1,
+		MaxSideBet:      250,
+		Rules: Rules{
+			DealerHitsSoft17: false,
+			DoubleAfterSplit: false,
+			DoubleOn:         DoubleNineToEleven,
+			MaxSplits:        1,
+			ResplitAces:      false,
+			HitSplitAces:     false,
+			Surrender:        SurrenderNone,
+			NoHoleCard:       true,
+			OriginalBetsOnly: false,
+		},
+	},
+	"spanish-21": {
+		Variant:         Spanish21,
+		Seats:           7,
+		MinBuyIn:        100,
+		MaxBuyIn:        50000,
+		MinWager:        10,
+		MaxWager:        5000,
+		Payout:          1,
+		InsurancePayout: 2.0,
+		BlackjackPayout: 1.5,
+		Decks:           6,
+		Penetration:     0.75,
+		MinSideBet:      1,
+		MaxSideBet:      250,
+		Rules: Rules{
+			DealerHitsSoft17: true,
+			DoubleAfterSplit: true,
+			DoubleOn:         DoubleAnyTwo,
+			DoubleAnyCards:   true,
+			MaxSplits:        3,
+			ResplitAces:      true,
+			HitSplitAces:     true,
+			Surrender:        SurrenderLate,
+			SurrenderSplits:  true,
+		},
+	},
+	"blackjack-switch": {
+		Variant:         BlackjackSwitch,
+		Seats:           6,
+		MinBuyIn:        100,
+		MaxBuyIn:        50000,
+		MinWager:        10,
+		MaxWager:        5000,
+		Payout:          1,
+		InsurancePayout: 2.0,
+		BlackjackPayout: 1.0,
+		Decks:           6,
+		Penetration:     0.75,
+		MinSideBet:      1,
+		MaxSideBet:      250,
+		Rules: Rules{
+			DealerHitsSoft17: true,
+			DoubleAfterSplit: true,
+			DoubleOn:         DoubleAnyTwo,
+			MaxSplits:        3,
+			ResplitAces:      false,
+			HitSplitAces:     false,
+			Surrender:        SurrenderNone,
+		},
+	},
+	"double-exposure": {
+		Variant:         DoubleExposure,
+		Seats:           7,
+		MinBuyIn:        100,
+		MaxBuyIn:        50000,
+		MinWager:        10,
+		MaxWager:        5000,
+		Payout:          1,
+		InsurancePayout: 2.0,
+		BlackjackPayout: 1.0,
+		Decks:           6,
+		Penetration:     0.75,
+		MinSideBet:      1,
+		MaxSideBet:      250,
+		Rules: Rules{
+			DealerHitsSoft17: true,
+			DoubleAfterSplit: false,
+			DoubleOn:         DoubleNineToEleven,
+			MaxSplits:        1,
+			ResplitAces:      false,
+			HitSplitAces:     false,
+			Surrender:        SurrenderNone,
+		},
+	},
+	"free-bet": {
+		Variant:         FreeBetBlackjack,
+		Seats:           7,
+		MinBuyIn:        100,
+		MaxBuyIn:        50000,
+		MinWager:        10,
+		MaxWager:        5000,
+		Payout:          1,
+		InsurancePayout: 2.0,
+		BlackjackPayout: 1.5,
+		Decks:           6,
+		Penetration:     0.75,
+		MinSideBet:      1,
+		MaxSideBet:      250,
+		Rules: Rules{
+			DealerHitsSoft17: true,
+			DoubleAfterSplit: true,
+			DoubleOn:         DoubleAnyTwo,
+			MaxSplits:        3,
+			ResplitAces:      false,
+			HitSplitAces:     false,
+			Surrender:        SurrenderNone,
+		},
+	},
+	"infinite": {
+		Variant:         InfiniteBlackjack,
+		Seats:           7,
+		MinBuyIn:        10,
+		MaxBuyIn:        50000,
+		MinWager:        1,
+		MaxWager:        2500,
+		Payout:          1,
+		InsurancePayout: 2.0,
+		BlackjackPayout: 1.5,
+		Decks:           8,
+		Penetration:     0.75,
+		MinSideBet:      1,
+		MaxSideBet:      250,
+		SideBets: map[SideBetType]Paytable{
+			PairBet:       nil,
+			TwentyOne3Bet: nil,
+		},
+		Rules: Rules{
+			DealerHitsSoft17: false,
+			DoubleAfterSplit: true,
+			DoubleOn:         DoubleAnyTwo,
+			MaxSplits:        1,
+			ResplitAces:      false,
+			HitSplitAces:     false,
+			Surrender:        SurrenderNone,
+		},
+	},
+}
+
+// Adds or replaces a named preset, once it passes validation.
+func RegisterPreset(name string, cfg GameConfig) error {
+	cfg.Preset = name
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	presets[name] = cfg
+	return nil
+}
+
+// Returns the registered preset names in sorted order.
+func Presets() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Copies the offered side bets so a loaded config never shares paytables with the registry.
+func cloneSideBets(src map[SideBetType]Paytable) map[SideBetType]Paytable {
+	dst := make(map[SideBetType]Paytable, len(src))
+	for t, pt := range src {
+		dst[t] = maps.Clone(pt)
+	}
+	return dst
+}
+
+// Returns a copy of the named preset with any overrides applied.
+func LoadPreset(name string, overrides ...ConfigOverride) (*GameConfig, error) {
+	cfg, ok := presets[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownPreset, name)
+	}
+	cfg.Preset = name
+	cfg.SideBets = cloneSideBets(cfg.SideBets)
+	for _, override := range overrides {
+		override(&cfg)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Checks that a table can be opened with the config.
+func (c *GameConfig) Validate() error {
+	if c.Decks < 1 {
+		return fmt.Errorf("%w: %d decks", ErrInvalidConfig, c.Decks)
+	}
+	if c.MinWager <= 0 || c.MinWager > c.MaxWager {
+		return fmt.Errorf("%w: wager limits %d-%d", ErrInvalidConfig, c.MinWager, c.MaxWager)
+	}
+	if c.MinSideBet > c.MaxSideBet {
+		return fmt.Errorf("%w: side bet limits %d-%d", ErrInvalidConfig, c.MinSideBet, c.MaxSideBet)
+	}
+	// Shared-hand tables seat any number of players.
+	shared := false
+	if def, ok := LookupVariant(c.Variant); ok {
+		shared = def.SharedHand
+	}
+	if c.Seats < 1 || (c.Seats > MaxSeats && !shared) {
+		return fmt.Errorf("%w: %d seats, want 1 to %d", ErrInvalidConfig, c.Seats, MaxSeats)
+	}
+	// Progressive payouts are shares of the linked pool, set on its ProgressiveConfig.
+	if c.SideBets[ProgressiveBet] != nil {
+		return fmt.Errorf("%w: progressive tiers are set by the progressive, not a paytable", ErrInvalidConfig)
+	}
+	return nil
+}
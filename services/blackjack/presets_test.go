@@ -0,0 +1,55 @@
+package blackjack
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLoadPresetOverrides(t *testing.T) {
+	cfg, err := LoadPreset(DefaultPreset, func(c *GameConfig) { c.MinWager = 10 })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Preset != DefaultPreset || cfg.MinWager != 10 {
+		t.Fatalf("loaded %s with MinWager %d, want %s with 10", cfg.Preset, cfg.MinWager, DefaultPreset)
+	}
+	// Overrides apply to the copy, never the registry.
+	if again, _ := LoadPreset(DefaultPreset); again.MinWager == 10 {
+		t.Fatal("override leaked into the registered preset")
+	}
+	if _, err := LoadPreset("no-such-table"); err == nil {
+		t.Fatal("unknown preset loaded")
+	}
+}
+
+func TestLoadPresetValidates(t *testing.T) {
+	tests := []struct {
+		name     string
+		override ConfigOverride
+	}{
+		{"no decks", func(c *GameConfig) { c.Decks = 0 }},
+		{"no minimum wager", func(c *GameConfig) { c.MinWager = 0 }},
+		{"minimum above maximum", func(c *GameConfig) { c.MinWager = c.MaxWager + 1 }},
+		{"side bet minimum above maximum", func(c *GameConfig) { c.MinSideBet = c.MaxSideBet + 1 }},
+		{"no seats", func(c *GameConfig) { c.Seats = 0 }},
+		{"too many seats", func(c *GameConfig) { c.Seats = MaxSeats + 1 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadPreset(DefaultPreset, tt.override); !errors.Is(err, ErrInvalidConfig) {
+				t.Fatalf("err = %v, want %v", err, ErrInvalidConfig)
+			}
+		})
+	}
+}
+
+func TestRegisterPresetValidates(t *testing.T) {
+	cfg, _ := LoadPreset(DefaultPreset)
+	cfg.Decks = 0
+	if err := RegisterPreset("test-no-decks", *cfg); !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("err = %v, want %v", err, ErrInvalidConfig)
+	}
+	if _, err := LoadPreset("test-no-decks"); err == nil {
+		t.Fatal("invalid preset was registered")
+	}
+}
@@ -0,0 +1,226 @@
+package blackjack
+
+import (
+	// Standard libs
+	"math"
+	"sync"
+	// Internal
+	"casino/libs/store"
+)
+
+//	----- Progressive Jackpot -----
+
+/*
+A jackpot pool shared by every table linked to it.
+Each wager at a linked table contributes a percentage to the pool, part of
+which is held back in a reserve that funds the reset after a hit.
+The pool is its own event stream: every change is appended as an event and
+state is only ever updated by applying events, so the pool can be rebuilt
+by replaying its stream.  Balances are held in whole cents, with every
+contribution rounded to the cent, so a replay is exact.  Contributions from
+many tables are serialised by the pool's lock.
+*/
+type Progressive struct {
+	mu      sync.Mutex
+	Name    string
+	Config  ProgressiveConfig
+	Store   *store.EventStore
+	pool    int // Cents
+	reserve int // Cents
+}
+
+type ProgressiveConfig struct {
+	Seed             int          // Pool value when the progressive opens
+	Reset            int          // Pool value after the jackpot is hit
+	ContributionRate float64      // Share of every wager added, 0.01 = 1%
+	ReserveSplit     float64      // Share of each contribution held for the reset
+	Tiers            JackpotTiers // Winning tiers of the progressive bet
+}
+
+// Maps a winning tier to its share of the pool, 1.0 = whole pool.  Unlike a
+// Paytable these are not odds; a table cannot set them through GameConfig.SideBets.
+type JackpotTiers map[string]float64
+
+// Cents in one chip; pool balances are held in cents.
+const CentsPerChip = 100
+
+const (
+	SuitedSevens  = "SUITED_777"
+	SuitedAceJack = "SUITED_AJ"
+)
+
+const (
+	ProgressiveSeeded       = "ProgressiveSeeded"
+	ProgressiveContribution = "ProgressiveContribution"
+	ProgressiveHit          = "ProgressiveHit"
+	ProgressiveReset        = "ProgressiveReset"
+)
+
+func init() {
+	RegisterSideBet(SideBetDefinition{
+		Type:               ProgressiveBet,
+		Window:             StateBetsOpen,
+		Trigger:            ResolveAfterDealerTurn,
+		RequiresDealerHand: false,
+		Offered: func(g *Game) bool {
+			return g.Progressive != nil
+		},
+		Resolve: func(g *Game, p *Player, h *Hand) string {
+			return progressiveTier(g.dealtCards(h))
+		},
+		Pay: func(g *Game, p *Player, sb *SideBet, tier string) int {
+			share := g.Progressive.Config.Tiers[tier]
+			if share <= 0 {
+				return 0
+			}
+			return g.Progressive.Award(share, p.ID, g.RoundId) + sb.Amount
+		},
+	})
+}
+
+// Returns a typical configuration: 1% of wagers, a fifth held in reserve.
+func DefaultProgressiveConfig() ProgressiveConfig {
+	return ProgressiveConfig{
+		Seed:             10000,
+		Reset:            10000,
+		ContributionRate: 0.01,
+		ReserveSplit:     0.2,
+		Tiers: JackpotTiers{
+			SuitedSevens:  1.0,
+			SuitedAceJack: 0.1,
+		},
+	}
+}
+
+// Creates a progressive on a fresh stream, seeded with the configured amount.
+func NewProgressive(name string, cfg ProgressiveConfig) *Progressive {
+	p := &Progressive{
+		Name:   name,
+		Config: cfg,
+		Store:  store.NewEventStore(),
+	}
+	p.record(ProgressiveSeeded, map[string]any{
+		"Progressive": name,
+		"Pool":        cfg.Seed * CentsPerChip,
+		"Reserve":     0,
+	})
+	return p
+}
+
+// Rebuilds a progressive by replaying an existing stream.
+func LoadProgressive(name string, cfg ProgressiveConfig, st *store.EventStore) *Progressive {
+	p := &Progressive{
+		Name:   name,
+		Config: cfg,
+		Store:  st,
+	}
+	for _, e := range st.All() {
+		p.apply(e)
+	}
+	return p
+}
+
+// Returns the current pool value in cents.
+func (p *Progressive) Pool() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.pool
+}
+
+// Returns the amount held back for the next reset, in cents.
+func (p *Progressive) Reserve() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.reserve
+}
+
+// Adds the configured share of a wager to the pool and reserve.
+func (p *Progressive) Contribute(wager int) {
+	if wager <= 0 || p.Config.ContributionRate <= 0 {
+		return
+	}
+	contribution := int(math.Round(float64(wager*CentsPerChip) * p.Config.ContributionRate))
+	toReserve := int(math.Round(float64(contribution) * p.Config.ReserveSplit))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.record(ProgressiveContribution, map[string]any{
+		"Progressive": p.Name,
+		"Wager":       wager,
+		"Pool":        p.pool + contribution - toReserve,
+		"Reserve":     p.reserve + toReserve,
+	})
+}
+
+// Pays a share of the pool to a player and returns the whole chips paid;
+// fractions of a chip stay in the pool.  A full hit resets the pool, funded
+// first from the reserve.
+func (p *Progressive) Award(share float64, playerID string, roundID int) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if share > 1 {
+		share = 1
+	}
+	amount := int(math.Floor(float64(p.pool)*share)) / CentsPerChip
+	p.record(ProgressiveHit, map[string]any{
+		"Progressive": p.Name,
+		"PlayerID":    playerID,
+		"RoundID":     roundID,
+		"Amount":      amount,
+		"Pool":        p.pool - amount*CentsPerChip,
+		"Reserve":     p.reserve,
+	})
+
+	if share == 1 {
+		reset := p.Config.Reset * CentsPerChip
+		fromReserve := min(p.reserve, reset)
+		p.record(ProgressiveReset, map[string]any{
+			"Progressive": p.Name,
+			"FromReserve": fromReserve,
+			"FromHouse":   reset - fromReserve,
+			"Pool":        p.pool + reset,
+			"Reserve":     p.reserve - fromReserve,
+		})
+	}
+	return amount
+}
+
+// Appends an event to the stream and applies it.  Callers hold the lock.
+func (p *Progressive) record(eventType string, payload map[string]any) {
+	e := store.Event{Type: eventType, Payload: payload}
+	p.Store.Append(e)
+	p.apply(e)
+}
+
+// Applies an event to the pool state.  Every event carries the resulting balances.
+func (p *Progressive) apply(e store.Event) {
+	payload, ok := e.Payload.(map[string]any)
+	if !ok {
+		return
+	}
+	if pool, ok := payload["Pool"].(int); ok {
+		p.pool = pool
+	}
+	if reserve, ok := payload["Reserve"].(int); ok {
+		p.reserve = reserve
+	}
+}
+
+// Returns the progressive tier for the first cards dealt to a box, or an empty string.
+func progressiveTier(cards []Card) string {
+	if len(cards) >= 3 {
+		c1, c2, c3 := cards[0], cards[1], cards[2]
+		if c1.Rank == "7" && c2.Rank == "7" && c3.Rank == "7" && c1.Suit == c2.Suit && c2.Suit == c3.Suit {
+			return SuitedSevens
+		}
+	}
+	if len(cards) >= 2 {
+		c1, c2 := cards[0], cards[1]
+		aceJack := (c1.Rank == "A" && c2.Rank == "J") || (c1.Rank == "J" && c2.Rank == "A")
+		if aceJack && c1.Suit == c2.Suit {
+			return SuitedAceJack
+		}
+	}
+	return ""
+}
@@ -0,0 +1,107 @@
+package blackjack
+
+import (
+	// Standard libs
+	"testing"
+	// Internal
+	"casino/libs/store"
+)
+
+func TestProgressiveTier(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []Card
+		want  string
+	}{
+		{"suited sevens", []Card{suited("7", "Clubs"), suited("7", "Clubs"), suited("7", "Clubs")}, SuitedSevens},
+		{"mixed sevens", []Card{suited("7", "Clubs"), suited("7", "Clubs"), suited("7", "Spades")}, ""},
+		{"two sevens", []Card{suited("7", "Clubs"), suited("7", "Clubs")}, ""},
+		{"suited ace jack", []Card{suited("J", "Spades"), suited("A", "Spades")}, SuitedAceJack},
+		{"mixed ace jack", []Card{suited("A", "Spades"), suited("J", "Hearts")}, ""},
+	}
+	for _, tt := range tests {
+		if got := progressiveTier(tt.cards); got != tt.want {
+			t.Errorf("%s: tier = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestProgressiveReplay(t *testing.T) {
+	cfg := DefaultProgressiveConfig()
+	cfg.ContributionRate = 0.013
+	p := NewProgressive("linked", cfg)
+	// Each wager of 7 contributes 9.1 cents, rounded to 9, of which 2 go to
+	// the reserve.
+	for range 800 {
+		p.Contribute(7)
+	}
+	if want := cfg.Seed*CentsPerChip + 800*7; p.Pool() != want {
+		t.Fatalf("pool = %d, want %d", p.Pool(), want)
+	}
+	if want := 800 * 2; p.Reserve() != want {
+		t.Fatalf("reserve = %d, want %d", p.Reserve(), want)
+	}
+
+	p.Award(0.1, "1", 1)
+	replayed := LoadProgressive("linked", cfg, p.Store)
+	if replayed.Pool() != p.Pool() || replayed.Reserve() != p.Reserve() {
+		t.Fatalf("replayed pool %d reserve %d, want %d and %d", replayed.Pool(), replayed.Reserve(), p.Pool(), p.Reserve())
+	}
+}
+
+func TestProgressiveAward(t *testing.T) {
+	cfg := DefaultProgressiveConfig()
+	p := NewProgressive("linked", cfg)
+	p.Contribute(250)
+
+	// 1,000,250 cents less a reserve of 50; a tenth is 100,020 cents, paid
+	// as 1000 whole chips.
+	if got := p.Award(0.1, "1", 1); got != 1000 {
+		t.Fatalf("award = %d, want 1000", got)
+	}
+	if want := 1000200 - 100000; p.Pool() != want {
+		t.Fatalf("pool = %d, want %d", p.Pool(), want)
+	}
+
+	// A full hit pays the whole pool and resets, funded first from the reserve.
+	if got := p.Award(1, "1", 2); got != 9002 {
+		t.Fatalf("award = %d, want 9002", got)
+	}
+	if want := cfg.Reset * CentsPerChip; p.Pool() != want {
+		t.Fatalf("pool after reset = %d, want %d", p.Pool(), want)
+	}
+	if p.Reserve() != 0 {
+		t.Fatalf("reserve after reset = %d, want 0", p.Reserve())
+	}
+}
+
+func TestProgressiveHit(t *testing.T) {
+	offer := func(c *GameConfig) {
+		c.SideBets = map[SideBetType]Paytable{ProgressiveBet: nil}
+	}
+	g, p := openRound(t, DefaultPreset, 10, []string{"7", "10", "7", "8", "7"}, offer)
+	g.Progressive = NewProgressive("linked", DefaultProgressiveConfig())
+	if _, err := (PlaceSideBet{Type: ProgressiveBet, Amount: 5}).Execute(g, p, nil); err != nil {
+		t.Fatal(err)
+	}
+	deal(g, p)
+	playRound(t, g, p, []play{{0, Hit{}}})
+
+	sb := p.Hands[0].SideBets[0]
+	if sb.Tier != SuitedSevens {
+		t.Fatalf("tier = %q, want %q", sb.Tier, SuitedSevens)
+	}
+	// 21 beats the dealer's 18, and the sevens take the whole pool.
+	if want := 9985 + 20 + 10000 + 5; p.LocalWallet != want {
+		t.Fatalf("wallet = %d, want %d", p.LocalWallet, want)
+	}
+}
+
+func TestProgressiveRejectsPaytable(t *testing.T) {
+	_, err := NewGameFromPreset(store.NewEventStore(), DefaultPreset, func(c *GameConfig) {
+		c.SideBets = map[SideBetType]Paytable{ProgressiveBet: {SuitedSevens: 1000}}
+	})
+	if err == nil {
+		t.Fatal("progressive paytable accepted")
+	}
+}
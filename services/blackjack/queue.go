@@ -70,6 +70,7 @@ func (g *Game) Peek() (Turn, bool) {
 // ------- Advance Turn ------
 
 // Wrapper around Next that processes a turn and advances game state.
+// Turns for hands that already stand, such as split aces, are skipped.
 func (g *Game) AdvanceTurn() (Turn, bool) {
 	t, ok := g.Next()
 	if !ok {
@@ -86,6 +87,10 @@ func (g *Game) AdvanceTurn() (Turn, bool) {
 		return Turn{}, false
 	}
 
+	for len(g.TurnQueue) > 0 && g.TurnQueue[0].Hand != nil && g.TurnQueue[0].Hand.Stood {
+		g.Next()
+	}
+
 	if len(g.TurnQueue) == 0 {
 		g.State = StateDealerTurn
 	} else {
@@ -0,0 +1,103 @@
+package blackjack
+
+//	----- House Rules -----
+
+/*
+Rules holds the table rules that vary from house to house.
+Every action and the dealer loop consult the rules on the game config
+rather than hard-coding a single rule set.
+*/
+type Rules struct {
+	DealerHitsSoft17 bool              // H17 when true, S17 otherwise
+	DoubleAfterSplit bool              // DAS
+	DoubleOn         DoubleRestriction // Totals a player may double on
+	DoubleAnyCards   bool              // Doubling allowed on three or more cards
+	MaxSplits        int               // Number of splits allowed per hand, capped by MaxHandsPerPlayer
+	ResplitAces      bool              // Split aces may be split again
+	HitSplitAces     bool              // Split aces may take more than one card
+	Surrender        SurrenderMode     // Surrender offered at the table
+	SurrenderSplits  bool              // Split hands may be surrendered
+	NoHoleCard       bool              // ENHC; dealer takes a second card only after players act
+	OriginalBetsOnly bool              // OBO; with NoHoleCard only original bets lose to a dealer blackjack
+}
+
+type DoubleRestriction string
+
+const (
+	DoubleAnyTwo       DoubleRestriction = "ANY_TWO"
+	DoubleNineToEleven DoubleRestriction = "NINE_TO_ELEVEN"
+	DoubleTenToEleven  DoubleRestriction = "TEN_TO_ELEVEN"
+)
+
+type SurrenderMode string
+
+// Early modes are offered before the dealer peeks; late surrender
+// remains available on the first action afterwards.
+const (
+	SurrenderNone      SurrenderMode = "NONE"
+	SurrenderLate      SurrenderMode = "LATE"
+	SurrenderEarly     SurrenderMode = "EARLY"      // Early against an Ace or ten up
+	SurrenderEarlyTens SurrenderMode = "EARLY_TENS" // Early against a ten up only
+)
+
+// Returns the rules the engine played with before rules were configurable.
+func DefaultRules() Rules {
+	return Rules{
+		DealerHitsSoft17: false,
+		DoubleAfterSplit: true,
+		DoubleOn:         DoubleAnyTwo,
+		DoubleAnyCards:   false,
+		MaxSplits:        MaxHandsPerPlayer - 1,
+		ResplitAces:      true,
+		HitSplitAces:     true,
+		Surrender:        SurrenderLate,
+		SurrenderSplits:  true,
+		NoHoleCard:       false,
+		OriginalBetsOnly: false,
+	}
+}
+
+// Returns the number of hands a player may hold after splitting.
+func (r Rules) MaxHands() int {
+	hands := r.MaxSplits + 1
+	if hands < 1 {
+		hands = 1
+	}
+	if hands > MaxHandsPerPlayer {
+		hands = MaxHandsPerPlayer
+	}
+	return hands
+}
+
+// Checks the hand total against the double restriction.
+func (r Rules) CanDoubleOn(total int) bool {
+	switch r.DoubleOn {
+	case DoubleNineToEleven:
+		return total >= 9 && total <= 11
+	case DoubleTenToEleven:
+		return total == 10 || total == 11
+	default:
+		return true
+	}
+}
+
+// Returns true if early surrender is offered against the dealer up card.
+func (r Rules) EarlySurrenderAgainst(up Card) bool {
+	switch r.Surrender {
+	case SurrenderEarly:
+		return up.Rank == "A" || isTenValue(up.Rank)
+	case SurrenderEarlyTens:
+		return isTenValue(up.Rank)
+	default:
+		return false
+	}
+}
+
+// Returns true if the dealer must draw to the given hand.
+func (r Rules) DealerDraws(h *Hand) bool {
+	v := h.Value()
+	if v < 17 {
+		return true
+	}
+	return v == 17 && r.DealerHitsSoft17 && h.IsSoft()
+}
@@ -0,0 +1,65 @@
+package blackjack
+
+import "testing"
+
+func TestCanDoubleOn(t *testing.T) {
+	tests := []struct {
+		on    DoubleRestriction
+		total int
+		want  bool
+	}{
+		{DoubleAnyTwo, 4, true},
+		{DoubleAnyTwo, 20, true},
+		{DoubleNineToEleven, 8, false},
+		{DoubleNineToEleven, 9, true},
+		{DoubleNineToEleven, 11, true},
+		{DoubleTenToEleven, 9, false},
+		{DoubleTenToEleven, 10, true},
+		{DoubleTenToEleven, 12, false},
+	}
+	for _, tt := range tests {
+		r := Rules{DoubleOn: tt.on}
+		if got := r.CanDoubleOn(tt.total); got != tt.want {
+			t.Errorf("%s on %d = %v, want %v", tt.on, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestMaxHands(t *testing.T) {
+	tests := []struct {
+		splits int
+		want   int
+	}{
+		{-1, 1},
+		{0, 1},
+		{1, 2},
+		{3, MaxHandsPerPlayer},
+		{10, MaxHandsPerPlayer},
+	}
+	for _, tt := range tests {
+		if got := (Rules{MaxSplits: tt.splits}).MaxHands(); got != tt.want {
+			t.Errorf("MaxSplits %d: MaxHands = %d, want %d", tt.splits, got, tt.want)
+		}
+	}
+}
+
+func TestDealerDraws(t *testing.T) {
+	tests := []struct {
+		name string
+		h17  bool
+		hand *Hand
+		want bool
+	}{
+		{"hard 16", false, hand("10", "6"), true},
+		{"hard 17", true, hand("10", "7"), false},
+		{"soft 17 S17", false, hand("A", "6"), false},
+		{"soft 17 H17", true, hand("A", "6"), true},
+		{"soft 18 H17", true, hand("A", "7"), false},
+	}
+	for _, tt := range tests {
+		r := Rules{DealerHitsSoft17: tt.h17}
+		if got := r.DealerDraws(tt.hand); got != tt.want {
+			t.Errorf("%s: DealerDraws = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
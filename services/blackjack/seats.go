@@ -0,0 +1,236 @@
+package blackjack
+
+import (
+	// Standard libs
+	"fmt"
+	// Internal
+	"casino/libs/store"
+)
+
+//	----- Seats -----
+
+/*
+A table has between one and MaxSeats boxes, set by GameConfig.Seats.
+Seats are numbered from 1 in dealing order.  A player may play several
+boxes at once; each box carries its own wager and side bets and is dealt
+and played separately.  Players may only join or leave between rounds,
+never once bets are closed.  Shared-hand tables add a seat for every
+player who joins a full table.
+*/
+
+const MaxSeats = 7
+
+// A betting spot at the table.
+type Box struct {
+	Seat     int
+	Player   *Player
+	Bet      int        // Main wager placed for the round
+	SideBets []*SideBet // Side bets placed before the deal
+	Hand     *Hand      // Initial hand dealt to the box
+	Dealt    []Card     // First three cards dealt to the box, kept as dealt through splits
+	Behind   []*BackBet // Wagers placed behind the box by other participants
+}
+
+// Clears the box ahead of a new round.
+func (b *Box) clear() {
+	for _, bb := range b.Behind {
+		bb.Bettor.TotalBet = 0
+	}
+	b.Bet = 0
+	b.SideBets = nil
+	b.Hand = nil
+	b.Dealt = nil
+	b.Behind = nil
+}
+
+// Returns true if a box is dealt into the round: it carries a main wager
+// and, where the variant seats a player in several boxes, every one of the
+// player's boxes carries the same wager.
+func (g *Game) wagerComplete(b *Box) bool {
+	if b.Bet == 0 {
+		return false
+	}
+	if g.variant().BoxesPerPlayer > 1 {
+		for _, other := range g.BoxesFor(b.Player) {
+			if other.Bet != b.Bet {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Returns every wager on a box left out of the deal: the main wager, side
+// bets and the bets behind it.
+func (g *Game) returnWagers(b *Box) {
+	returned := b.Bet
+	for _, sb := range b.SideBets {
+		returned += sb.Amount
+	}
+	b.Player.LocalWallet += returned
+	b.Player.TotalBet -= returned
+	for _, bb := range b.Behind {
+		bb.Bettor.LocalWallet += bb.Amount
+		bb.Bettor.TotalBet -= bb.Amount
+	}
+	if returned > 0 || len(b.Behind) > 0 {
+		g.Store.Append(store.Event{
+			Type: "WagersReturned",
+			Payload: map[string]any{
+				"PlayerID": b.Player.ID,
+				"Seat":     b.Seat,
+				"Amount":   returned,
+				"BackBets": len(b.Behind),
+				"RoundID":  g.RoundId,
+			},
+		})
+	}
+	b.Bet = 0
+	b.SideBets = nil
+	b.Behind = nil
+}
+
+// Records a card dealt to a box's initial hand, up to the first three.
+func (g *Game) recordDealt(h *Hand, c Card) {
+	if b := g.BoxAt(h.Seat); b != nil && b.Hand == h && len(b.Dealt) < 3 {
+		b.Dealt = append(b.Dealt, c)
+	}
+}
+
+// Returns the first cards dealt to the box a hand is played from, in the
+// order dealt.  Side bets on the initial cards resolve against these, since
+// a split rewrites the hand itself.
+func (g *Game) dealtCards(h *Hand) []Card {
+	if b := g.BoxAt(h.Seat); b != nil && b.Hand == h {
+		return b.Dealt
+	}
+	return h.Cards
+}
+
+// Returns true while players may join or leave the table.
+func (g *Game) seatingOpen() bool {
+	return g.State == StateTableOpen || g.State == StateBetsOpen
+}
+
+// Seats a player in the given seat.  A player may hold several seats.
+func (g *Game) JoinSeat(p *Player, seat int) error {
+	if !g.seatingOpen() {
+		return &StateError{Action: "join a seat", State: g.State}
+	}
+	if p == nil {
+		return fmt.Errorf("%w: cannot seat a nil player", ErrUnknownPlayer)
+	}
+	if seat < 1 || seat > len(g.Seats) {
+		return fmt.Errorf("%w: seat %d; table has %d seats", ErrInvalidSeat, seat, len(g.Seats))
+	}
+	if g.Seats[seat-1] != nil {
+		return fmt.Errorf("%w: seat %d", ErrSeatTaken, seat)
+	}
+	if other := g.FindPlayer(p.ID); other != nil && other != p {
+		return fmt.Errorf("%w: %s", ErrDuplicatePlayer, p.ID)
+	}
+
+	g.Seats[seat-1] = &Box{Seat: seat, Player: p}
+	g.Store.Append(store.Event{
+		Type: "SeatJoined",
+		Payload: map[string]any{
+			"PlayerID": p.ID,
+			"Seat":     seat,
+			"Boxes":    len(g.BoxesFor(p)),
+			"RoundID":  g.RoundId,
+		},
+	})
+	return nil
+}
+
+// Seats a player in the first empty seat and returns the seat number.
+// Variants played over several boxes seat the player in as many empty
+// seats and return the first.
+func (g *Game) JoinTable(p *Player) (int, error) {
+	need := max(g.variant().BoxesPerPlayer, 1)
+	var free []int
+	for i, b := range g.Seats {
+		if b == nil && len(free) < need {
+			free = append(free, i+1)
+		}
+	}
+	if g.variant().SharedHand && g.seatingOpen() {
+		for len(free) < need {
+			g.Seats = append(g.Seats, nil)
+			free = append(free, len(g.Seats))
+		}
+	}
+	if len(free) < need {
+		return 0, ErrTableFull
+	}
+	for _, seat := range free {
+		if err := g.JoinSeat(p, seat); err != nil {
+			return 0, err
+		}
+	}
+	return free[0], nil
+}
+
+// Frees the given seat.
+func (g *Game) LeaveSeat(seat int) error {
+	if !g.seatingOpen() {
+		return &StateError{Action: "leave a seat", State: g.State}
+	}
+	if seat < 1 || seat > len(g.Seats) {
+		return fmt.Errorf("%w: seat %d; table has %d seats", ErrInvalidSeat, seat, len(g.Seats))
+	}
+	b := g.Seats[seat-1]
+	if b == nil {
+		return fmt.Errorf("%w: seat %d", ErrSeatEmpty, seat)
+	}
+	// A bet placed for the coming deal must play out first.
+	if b.Bet > 0 && b.Hand == nil {
+		return fmt.Errorf("%w: player %s has a bet on seat %d", ErrSeatInUse, b.Player.ID, seat)
+	}
+	if len(b.SideBets) > 0 && b.Hand == nil {
+		return fmt.Errorf("%w: player %s has side bets on seat %d", ErrSeatInUse, b.Player.ID, seat)
+	}
+	if len(b.Behind) > 0 && b.Hand == nil {
+		return fmt.Errorf("%w: seat %d has wagers placed behind it", ErrSeatInUse, seat)
+	}
+
+	g.Seats[seat-1] = nil
+	g.Store.Append(store.Event{
+		Type: "SeatLeft",
+		Payload: map[string]any{
+			"PlayerID": b.Player.ID,
+			"Seat":     seat,
+			"RoundID":  g.RoundId,
+		},
+	})
+	return nil
+}
+
+// Returns the seated player with the given ID, otherwise returns nil.
+func (g *Game) FindPlayer(id string) *Player {
+	for _, b := range g.Seats {
+		if b != nil && b.Player.ID == id {
+			return b.Player
+		}
+	}
+	return nil
+}
+
+// Returns the boxes played by a player in seat order.
+func (g *Game) BoxesFor(p *Player) []*Box {
+	var boxes []*Box
+	for _, b := range g.Seats {
+		if b != nil && b.Player == p {
+			boxes = append(boxes, b)
+		}
+	}
+	return boxes
+}
+
+// Returns the box at a seat, otherwise returns nil.
+func (g *Game) BoxAt(seat int) *Box {
+	if seat < 1 || seat > len(g.Seats) {
+		return nil
+	}
+	return g.Seats[seat-1]
+}
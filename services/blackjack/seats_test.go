@@ -0,0 +1,122 @@
+package blackjack
+
+import (
+	// Standard libs
+	"testing"
+	// Internal
+	"casino/libs/store"
+)
+
+// Opens a table from the default preset with the given number of seats.
+func openTable(t *testing.T, seats int) *Game {
+	t.Helper()
+	g, err := NewGameFromPreset(store.NewEventStore(), DefaultPreset, func(c *GameConfig) { c.Seats = seats })
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestJoinSeat(t *testing.T) {
+	g := openTable(t, 3)
+	p1, p2 := NewPlayer("1", "One"), NewPlayer("2", "Two")
+	if err := g.JoinSeat(p1, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		p    *Player
+		seat int
+	}{
+		{"seat taken", p2, 2},
+		{"no such seat", p2, 4},
+		{"seat zero", p2, 0},
+		{"player ID in use", NewPlayer("1", "Impostor"), 3},
+		{"nil player", nil, 3},
+	}
+	for _, tt := range tests {
+		if err := g.JoinSeat(tt.p, tt.seat); err == nil {
+			t.Errorf("%s: joined", tt.name)
+		}
+	}
+	if g.FindPlayer("1") != p1 || g.Seats[1].Player != p1 {
+		t.Fatal("player not found in seat 2")
+	}
+
+	g.State = StateBetsClosed
+	if err := g.JoinSeat(p2, 3); err == nil {
+		t.Fatal("joined once bets were closed")
+	}
+}
+
+func TestJoinTableFull(t *testing.T) {
+	g := openTable(t, 2)
+	for i, id := range []string{"1", "2"} {
+		seat, err := g.JoinTable(NewPlayer(id, id))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seat != i+1 {
+			t.Fatalf("seat = %d, want %d", seat, i+1)
+		}
+	}
+	if _, err := g.JoinTable(NewPlayer("3", "3")); err == nil {
+		t.Fatal("joined a full table")
+	}
+}
+
+func TestLeaveSeat(t *testing.T) {
+	g := openTable(t, 3)
+	p := NewPlayer("1", "One")
+	if err := g.JoinSeat(p, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.LeaveSeat(2); err == nil {
+		t.Fatal("left an empty seat")
+	}
+
+	g.State = StateBetsOpen
+	if err := g.PlaceBet(1, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.LeaveSeat(1); err == nil {
+		t.Fatal("left with a bet on the table")
+	}
+
+	g.BoxAt(1).Bet = 0
+	if err := g.LeaveSeat(1); err != nil {
+		t.Fatal(err)
+	}
+	if g.FindPlayer("1") != nil {
+		t.Fatal("player still seated")
+	}
+}
+
+func TestDealsSeatsInOrder(t *testing.T) {
+	g := openTable(t, 3)
+	p1, p3 := NewPlayer("1", "One"), NewPlayer("3", "Three")
+	if err := g.JoinSeat(p3, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.JoinSeat(p1, 1); err != nil {
+		t.Fatal(err)
+	}
+	g.Dealer.Shoe = stackedShoe("2", "3", "10", "4", "5", "7")
+
+	g.State = StateBetsOpen
+	for _, seat := range []int{1, 3} {
+		if err := g.PlaceBet(seat, 10); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g.State = StateBetsClosed
+	g.DealCards()
+
+	if got := p1.Hands[0].Value(); got != 6 {
+		t.Fatalf("seat 1 value = %d, want 6", got)
+	}
+	if got := p3.Hands[0].Value(); got != 8 {
+		t.Fatalf("seat 3 value = %d, want 8", got)
+	}
+}
@@ -0,0 +1,197 @@
+package blackjack
+
+import (
+	// Standard libs
+	"fmt"
+	// Internal
+	"casino/libs/store"
+)
+
+//	----- Shared Hand -----
+
+/*
+At an infinite, shared-hand table every box is dealt the same two cards and
+any number of players may sit.  Instead of taking turns from the queue, all
+hands still in play decide together at each decision point.  Players record
+their choice with Decide and ResolveDecisions then plays every choice at
+once: every hand that draws at that point is dealt the same card, so a
+single card from the shoe fans out to all of them.
+*/
+
+func init() {
+	RegisterVariant(VariantDefinition{
+		Variant:    InfiniteBlackjack,
+		SharedHand: true,
+	})
+}
+
+// Deals the same run of cards to every hand acting at one decision point.
+// The n-th card a hand draws is the n-th card drawn by every other hand,
+// taken from the shoe the first time any hand needs it.
+type FanOut struct {
+	shoe  *Shoe
+	cards []Card
+	next  int
+}
+
+func NewFanOut(s *Shoe) *FanOut {
+	return &FanOut{shoe: s}
+}
+
+// Draws the hand's next card in the shared run.
+func (f *FanOut) Draw() Card {
+	if f.next == len(f.cards) {
+		f.cards = append(f.cards, f.shoe.Draw())
+	}
+	c := f.cards[f.next]
+	f.next++
+	return c
+}
+
+// Starts the shared run over for the next hand.
+func (f *FanOut) Rewind() {
+	f.next = 0
+}
+
+// Returns the cards taken from the shoe so far.
+func (f *FanOut) Cards() []Card {
+	return f.cards
+}
+
+// Draws a card for a player's hand, from the fan-out while one is open.
+func (g *Game) draw() Card {
+	if g.fanOut != nil {
+		return g.fanOut.Draw()
+	}
+	return g.Dealer.Shoe.Draw()
+}
+
+// Moves an open fan-out on to the next hand.
+func (g *Game) nextHand() {
+	if g.fanOut != nil {
+		g.fanOut.Rewind()
+	}
+}
+
+// A player's choice for a hand at the current decision point.
+type Decision struct {
+	Player *Player
+	Hand   *Hand
+	Action Action
+}
+
+// Returns true while a hand still has a decision to make.
+func awaitingDecision(h *Hand) bool {
+	return h.Status == Qualified && !h.Stood && h.Value() < 21
+}
+
+// Returns the hands still to act at the current decision point, in seat order.
+func (g *Game) PendingHands() []Turn {
+	var turns []Turn
+	g.DoForEachBoxInPlay(func(b *Box) {
+		for _, h := range b.Player.Hands {
+			if h.Seat == b.Seat && awaitingDecision(h) {
+				turns = append(turns, Turn{Player: b.Player, Hand: h})
+			}
+		}
+	})
+	return turns
+}
+
+// Records a player's action for a hand at the current decision point,
+// replacing any earlier choice.  Nothing is dealt until ResolveDecisions.
+func (g *Game) Decide(pID string, idx HandIndex, action Action) error {
+	if !g.variant().SharedHand {
+		return fmt.Errorf("%w: shared decisions", ErrNotOffered)
+	}
+	if g.State != StatePlayerTurn {
+		return &StateError{Action: "decide", State: g.State}
+	}
+	p, h, err := g.resolveHand(pID, idx)
+	if err != nil {
+		return err
+	}
+	if h == nil {
+		return fmt.Errorf("%w %d for player %s", ErrUnknownHand, idx, pID)
+	}
+	if !awaitingDecision(h) {
+		return ErrHandNotInPlay
+	}
+	name, ok := actionName(action)
+	if !ok {
+		return fmt.Errorf("%w %T", ErrUnknownAction, action)
+	}
+	if err := g.validate(name, action.(Validator), p, h); err != nil {
+		return err
+	}
+
+	d := Decision{Player: p, Hand: h, Action: action}
+	replaced := false
+	for i := range g.Decisions {
+		if g.Decisions[i].Hand == h {
+			g.Decisions[i] = d
+			replaced = true
+		}
+	}
+	if !replaced {
+		g.Decisions = append(g.Decisions, d)
+	}
+
+	g.Store.Append(store.Event{
+		Type: "Decision",
+		Payload: map[string]any{
+			"PlayerID":  p.ID,
+			"HandIndex": h.Index,
+			"Action":    name,
+			"RoundID":   g.RoundId,
+		},
+	})
+	return nil
+}
+
+/*
+Plays every decision made at the current decision point in seat order,
+fanning out each card drawn to every hand that draws.  Hands left without a
+decision, or whose decision is no longer legal, stand.  When no hand is left
+to act the game moves on to the dealer's turn.
+*/
+func (g *Game) ResolveDecisions() {
+	if g.State != StatePlayerTurn || !g.variant().SharedHand {
+		return
+	}
+
+	decided := make(map[*Hand]Action, len(g.Decisions))
+	for _, d := range g.Decisions {
+		decided[d.Hand] = d.Action
+	}
+
+	g.fanOut = NewFanOut(g.Dealer.Shoe)
+	for _, t := range g.PendingHands() {
+		action, ok := decided[t.Hand]
+		if !ok {
+			action = Stand{}
+		}
+		g.nextHand()
+		endTurn, err := action.Execute(g, t.Player, t.Hand)
+		if err != nil {
+			endTurn, _ = Stand{}.Execute(g, t.Player, t.Hand)
+		}
+		if endTurn && t.Hand.Status == Qualified {
+			t.Hand.Stood = true
+		}
+	}
+	g.Store.Append(store.Event{
+		Type: "DecisionPoint",
+		Payload: map[string]any{
+			"Cards":     g.fanOut.Cards(),
+			"Decisions": len(g.Decisions),
+			"RoundID":   g.RoundId,
+		},
+	})
+	g.fanOut = nil
+	g.Decisions = nil
+
+	if len(g.PendingHands()) == 0 {
+		g.State = StateDealerTurn
+	}
+}
@@ -0,0 +1,118 @@
+package blackjack
+
+import (
+	// Standard libs
+	"errors"
+	"testing"
+	// Internal
+	"casino/libs/store"
+)
+
+// Opens an infinite table with a player on a bet of 10 in each of the first
+// n seats and deals the round from the given ranks.
+func dealShared(t *testing.T, n int, ranks []string) (*Game, []*Player) {
+	t.Helper()
+	g, err := NewGameFromPreset(store.NewEventStore(), "infinite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.Dealer.Shoe = stackedShoe(ranks...)
+	g.State = StateBetsOpen
+	players := make([]*Player, n)
+	for i := range players {
+		players[i] = NewPlayer(string(rune('1'+i)), "Tester")
+		seat, err := g.JoinTable(players[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := g.PlaceBet(seat, 10); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g.State = StateBetsClosed
+	g.DealCards()
+	return g, players
+}
+
+func TestSharedDealFansOut(t *testing.T) {
+	// Every box shares 10,6 against the dealer's 9/8.
+	g, players := dealShared(t, 3, []string{"10", "9", "6", "8", "K"})
+	for _, p := range players {
+		if got := p.Hands[0].Cards; len(got) != 2 || got[0] != card("10") || got[1] != card("6") {
+			t.Fatalf("player %s dealt %v, want 10,6", p.ID, got)
+		}
+	}
+	if c := g.Dealer.Shoe.Draw(); c != card("K") {
+		t.Fatalf("next card = %v, want K", c)
+	}
+}
+
+func TestSharedDecisions(t *testing.T) {
+	// 10,6 against a dealer 9/8: two hands hit and share the 5, one stands.
+	g, players := dealShared(t, 3, []string{"10", "9", "6", "8", "5", "K"})
+	if g.State != StatePlayerTurn {
+		t.Fatalf("state = %s, want %s", g.State, StatePlayerTurn)
+	}
+	for _, p := range players[:2] {
+		if err := g.Decide(p.ID, 0, Hit{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := g.Decide(players[2].ID, 0, Stand{}); err != nil {
+		t.Fatal(err)
+	}
+	g.ResolveDecisions()
+
+	for _, p := range players[:2] {
+		if got := p.Hands[0].Cards; len(got) != 3 || got[2] != card("5") {
+			t.Fatalf("player %s holds %v, want a drawn 5", p.ID, got)
+		}
+	}
+	if got := len(players[2].Hands[0].Cards); got != 2 {
+		t.Fatalf("standing hand holds %d cards, want 2", got)
+	}
+	if g.State != StateDealerTurn {
+		t.Fatalf("state = %s, want %s", g.State, StateDealerTurn)
+	}
+	if c := g.Dealer.Shoe.Draw(); c != card("K") {
+		t.Fatalf("next card = %v, want K", c)
+	}
+}
+
+func TestSharedUndecidedStands(t *testing.T) {
+	g, players := dealShared(t, 2, []string{"10", "9", "2", "8", "5"})
+	if err := g.Decide(players[0].ID, 0, Hit{}); err != nil {
+		t.Fatal(err)
+	}
+	g.ResolveDecisions()
+	if got := len(players[1].Hands[0].Cards); got != 2 || !players[1].Hands[0].Stood {
+		t.Fatalf("undecided hand holds %d cards, want 2 and stood", got)
+	}
+	// 10,2,5 has a decision left to make.
+	if pending := g.PendingHands(); len(pending) != 1 || pending[0].Player != players[0] {
+		t.Fatalf("pending = %v, want the first player's hand", pending)
+	}
+}
+
+func TestSharedRejectsApplyAction(t *testing.T) {
+	g, players := dealShared(t, 1, []string{"10", "9", "6", "8"})
+	if _, err := ApplyAction(g, players[0].ID, Hit{}, 0); !errors.Is(err, ErrWrongState) {
+		t.Fatalf("err = %v, want %v", err, ErrWrongState)
+	}
+	if err := g.Decide(players[0].ID, 1, Hit{}); !errors.Is(err, ErrUnknownHand) {
+		t.Fatalf("err = %v, want %v", err, ErrUnknownHand)
+	}
+}
+
+func TestDecideNeedsSharedTable(t *testing.T) {
+	g, p := dealRound(t, DefaultPreset, 10, []string{"10", "9", "6", "8"})
+	if err := g.Decide(p.ID, 0, Hit{}); !errors.Is(err, ErrNotOffered) {
+		t.Fatalf("err = %v, want %v", err, ErrNotOffered)
+	}
+}
+
+func TestSharedSeatsUnlimited(t *testing.T) {
+	if _, err := LoadPreset("infinite", func(c *GameConfig) { c.Seats = 3 * MaxSeats }); err != nil {
+		t.Fatal(err)
+	}
+}
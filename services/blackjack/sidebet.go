@@ -1,19 +1,34 @@
 package blackjack
 
+import (
+	// Standard libs
+	"fmt"
+	"math"
+	// Internal
+	"casino/libs/fsm"
+	"casino/libs/store"
+)
+
 // Holds player side bets, including insurance.
 // All side bets are stored with the initial hand.
 type SideBet struct {
-	Type   SideBetType
-	Amount int
-	Paid   bool
+	Type     SideBetType
+	Amount   int
+	Paid     bool
+	Resolved bool
+	Tier     string // Paytable tier hit, empty on a loss
+	Payout   int    // Amount returned to the player, stake included
 }
 
 type SideBetType string
 
 const (
-	InsuranceBet  SideBetType = "INSURANCE"
-	DealerBustBet SideBetType = "DEALER_BUST"
-	PairBet       SideBetType = "PLAYER_PAIR"
+	InsuranceBet   SideBetType = "INSURANCE"
+	DealerBustBet  SideBetType = "DEALER_BUST"
+	PairBet        SideBetType = "PLAYER_PAIR"
+	TwentyOne3Bet  SideBetType = "TWENTY_ONE_PLUS_THREE"
+	LuckyLadyBet   SideBetType = "LUCKY_LADIES"
+	ProgressiveBet SideBetType = "PROGRESSIVE"
 )
 
 func NewSideBet(betType SideBetType, bet int) *SideBet {
@@ -31,3 +46,236 @@ func (s *SideBet) MarkPaid() {
 func (s *SideBet) IsPaid() bool {
 	return s.Paid
 }
+
+//	----- Side Bet Engine -----
+
+/*
+Each side bet type registers when it may be placed, when it resolves and
+its default paytable.  The game resolves side bets at each trigger point
+and Settle sweeps up anything still open, so new side bets never require
+changes to the settlement loop.
+*/
+
+// Point in the round at which a side bet is resolved.
+type SideBetTrigger string
+
+// AFTER_PEEK fires once the dealer's blackjack is known; at no-hole-card
+// tables that is when the dealer draws the second card.
+const (
+	ResolveAfterDeal       SideBetTrigger = "AFTER_DEAL"
+	ResolveAfterPeek       SideBetTrigger = "AFTER_PEEK"
+	ResolveAfterDealerTurn SideBetTrigger = "AFTER_DEALER_TURN"
+)
+
+// Maps a winning tier to its payout ratio, e.g. 25.0 = 25:1.
+type Paytable map[string]float64
+
+type SideBetDefinition struct {
+	Type    SideBetType
+	Window  fsm.State // State in which the bet may be placed
+	Trigger SideBetTrigger
+	// The dealer completes the hand while the bet is live, even if every player has busted.
+	RequiresDealerHand bool
+	// Default paytable for a table; GameConfig.SideBets may override it.
+	Paytable func(cfg *GameConfig) Paytable
+	// Returns the winning tier, or an empty string if the bet loses.
+	Resolve func(g *Game, p *Player, h *Hand) string
+	// Optional; returns false when the table cannot offer the bet.
+	Offered func(g *Game) bool
+	// Optional; pays a winning tier, stake included, in place of the paytable.
+	Pay func(g *Game, p *Player, sb *SideBet, tier string) int
+}
+
+var sideBets = map[SideBetType]SideBetDefinition{}
+
+// Adds or replaces a side bet definition.
+func RegisterSideBet(def SideBetDefinition) {
+	sideBets[def.Type] = def
+}
+
+// Returns the side bet definition for a type.
+func LookupSideBet(t SideBetType) (SideBetDefinition, bool) {
+	def, ok := sideBets[t]
+	return def, ok
+}
+
+// Returns true if the table offers the side bet.  Insurance is always offered.
+func (g *Game) OffersSideBet(t SideBetType) bool {
+	if t == InsuranceBet {
+		return true
+	}
+	if _, ok := g.Config.SideBets[t]; !ok {
+		return false
+	}
+	def, ok := LookupSideBet(t)
+	return ok && (def.Offered == nil || def.Offered(g))
+}
+
+// Returns the paytable in force at the table for a side bet.
+func (g *Game) SideBetPaytable(def SideBetDefinition) Paytable {
+	if pt := g.Config.SideBets[def.Type]; pt != nil {
+		return pt
+	}
+	if def.Paytable == nil {
+		return Paytable{}
+	}
+	return def.Paytable(g.Config)
+}
+
+// Places a side bet in its betting window.  Bets placed before the deal are
+// held by the box and moved to its initial hand when cards are dealt; Seat
+// picks the box, defaulting to the player's first.  The box must carry a
+// main wager.
+type PlaceSideBet struct {
+	Type   SideBetType
+	Amount int
+	Seat   int
+}
+
+func (PlaceSideBet) handless() {}
+
+func (a PlaceSideBet) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+	// Insurance has its own limits and checks; it is only taken through Insurance.
+	if a.Type == InsuranceBet {
+		return false, fmt.Errorf("%w: insurance is placed with the insurance action", ErrNotAllowed)
+	}
+
+	def, ok := LookupSideBet(a.Type)
+	if !ok || !g.OffersSideBet(a.Type) {
+		return false, fmt.Errorf("%w: side bet %s", ErrNotOffered, a.Type)
+	}
+
+	if g.State != def.Window {
+		return false, &StateError{Action: fmt.Sprintf("place %s side bet", a.Type), State: g.State}
+	}
+
+	if err := checkAmount("side bet", a.Amount, g.Config.MinSideBet, g.Config.MaxSideBet); err != nil {
+		return false, err
+	}
+
+	if err := checkFunds(p, a.Amount); err != nil {
+		return false, err
+	}
+
+	var box *Box
+	if h == nil {
+		box = g.BoxAt(a.Seat)
+		if a.Seat == 0 {
+			if boxes := g.BoxesFor(p); len(boxes) > 0 {
+				box = boxes[0]
+			}
+		}
+		if box == nil || box.Player != p {
+			return false, fmt.Errorf("%w: player %s has no box at seat %d", ErrSeatEmpty, p.ID, a.Seat)
+		}
+		if box.Bet == 0 {
+			return false, fmt.Errorf("%w: seat %d needs a main wager before side bets", ErrNotAllowed, box.Seat)
+		}
+	}
+
+	if err := g.takeWager(p, a.Amount); err != nil {
+		return false, err
+	}
+	sb := NewSideBet(a.Type, a.Amount)
+	seat := 0
+	if box != nil {
+		box.SideBets = append(box.SideBets, sb)
+		seat = box.Seat
+	} else {
+		h.SideBets = append(h.SideBets, sb)
+		seat = h.Seat
+	}
+
+	g.Store.Append(store.Event{
+		Type: "SideBet",
+		Payload: map[string]any{
+			"BetType":  a.Type,
+			"PlayerID": p.ID,
+			"Seat":     seat,
+			"RoundID":  g.RoundId,
+			"Amount":   a.Amount,
+		},
+	})
+	return false, nil
+}
+
+// Resolves open side bets registered for the trigger.
+func (g *Game) resolveSideBets(trigger SideBetTrigger) {
+	g.DoForEachBox(func(b *Box) {
+		p, h := b.Player, b.Hand
+		if h == nil {
+			return
+		}
+		for _, sb := range h.SideBets {
+			if sb == nil || sb.Resolved {
+				continue
+			}
+			def, ok := LookupSideBet(sb.Type)
+			if !ok || def.Trigger != trigger {
+				continue
+			}
+			g.settleSideBet(def, p, h, sb)
+		}
+	})
+}
+
+// Returns true if any open side bet needs the dealer to complete the hand.
+func (g *Game) sideBetsNeedDealerHand() bool {
+	for _, b := range g.GetSeats() {
+		if b == nil || b.Hand == nil {
+			continue
+		}
+		for _, sb := range b.Hand.SideBets {
+			if sb == nil || sb.Resolved {
+				continue
+			}
+			if def, ok := LookupSideBet(sb.Type); ok && def.RequiresDealerHand {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Resolves every side bet still open, whatever its trigger.
+func (g *Game) resolveOpenSideBets() {
+	for _, trigger := range []SideBetTrigger{ResolveAfterDeal, ResolveAfterPeek, ResolveAfterDealerTurn} {
+		g.resolveSideBets(trigger)
+	}
+}
+
+// Pays or collects a single side bet and logs the result.
+func (g *Game) settleSideBet(def SideBetDefinition, p *Player, h *Hand, sb *SideBet) {
+	tier := def.Resolve(g, p, h)
+	payout := 0
+	if tier != "" {
+		if def.Pay != nil {
+			payout = def.Pay(g, p, sb, tier)
+		} else if ratio, ok := g.SideBetPaytable(def)[tier]; ok {
+			payout = int(math.Round(float64(sb.Amount)*ratio)) + sb.Amount
+		}
+	}
+	result := Loss
+	if payout > 0 {
+		sb.Payout = payout
+		sb.Tier = tier
+		sb.MarkPaid()
+		p.LocalWallet += sb.Payout
+		result = Win
+	}
+	sb.Resolved = true
+
+	g.Store.Append(store.Event{
+		Type: string(StateBetsSettle),
+		Payload: map[string]any{
+			"BetType":     sb.Type,
+			"Result":      result,
+			"Tier":        sb.Tier,
+			"PlayerID":    p.ID,
+			"RoundID":     g.RoundId,
+			"WagerAmount": sb.Amount,
+			"Payout":      sb.Payout,
+			"LocalWallet": p.LocalWallet,
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package blackjack
+
+import (
+	// Standard libs
+	"errors"
+	"testing"
+)
+
+const suitedBet SideBetType = "TEST_SUITED"
+
+func init() {
+	// Pays when the first two cards share a suit.
+	RegisterSideBet(SideBetDefinition{
+		Type:    suitedBet,
+		Window:  StateBetsOpen,
+		Trigger: ResolveAfterDeal,
+		Paytable: func(cfg *GameConfig) Paytable {
+			return Paytable{"SUITED": 3}
+		},
+		Resolve: func(g *Game, p *Player, h *Hand) string {
+			if h.Cards[0].Suit == h.Cards[1].Suit {
+				return "SUITED"
+			}
+			return ""
+		},
+	})
+}
+
+// Offers the test side bet with its default paytable.
+func offerSuited(c *GameConfig) {
+	c.SideBets = map[SideBetType]Paytable{suitedBet: nil}
+}
+
+func TestPlaceSideBet(t *testing.T) {
+	tests := []struct {
+		name    string
+		offered bool
+		bet     PlaceSideBet
+		want    error
+	}{
+		{"placed", true, PlaceSideBet{Type: suitedBet, Amount: 5}, nil},
+		{"not offered", false, PlaceSideBet{Type: suitedBet, Amount: 5}, ErrNotOffered},
+		{"below minimum", true, PlaceSideBet{Type: suitedBet, Amount: 0}, ErrInvalidAmount},
+		{"above maximum", true, PlaceSideBet{Type: suitedBet, Amount: 501}, ErrInvalidAmount},
+		{"no such seat", true, PlaceSideBet{Type: suitedBet, Amount: 5, Seat: 2}, ErrSeatEmpty},
+		// Insurance is only taken through the insurance action and its limits.
+		{"insurance", true, PlaceSideBet{Type: InsuranceBet, Amount: 500}, ErrNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var overrides []ConfigOverride
+			if tt.offered {
+				overrides = append(overrides, offerSuited)
+			}
+			g, p := openRound(t, DefaultPreset, 10, nil, overrides...)
+			_, err := tt.bet.Execute(g, p, nil)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			want := 9990
+			if tt.want == nil {
+				want -= tt.bet.Amount
+			}
+			if p.LocalWallet != want {
+				t.Fatalf("wallet = %d, want %d", p.LocalWallet, want)
+			}
+		})
+	}
+}
+
+func TestSideBetResolves(t *testing.T) {
+	g, p := openRound(t, DefaultPreset, 10, []string{"10", "9", "8", "7"}, offerSuited)
+	if _, err := (PlaceSideBet{Type: suitedBet, Amount: 5}).Execute(g, p, nil); err != nil {
+		t.Fatal(err)
+	}
+	deal(g, p)
+
+	// Resolved after the deal at 3:1, and out of its window once cards are out.
+	sb := p.Hands[0].SideBets[0]
+	if !sb.Resolved || sb.Payout != 20 || p.LocalWallet != 9985+20 {
+		t.Fatalf("side bet paid %d, wallet %d; want 20 and %d", sb.Payout, p.LocalWallet, 9985+20)
+	}
+	if _, err := (PlaceSideBet{Type: suitedBet, Amount: 5}).Execute(g, p, p.Hands[0]); !errors.Is(err, ErrWrongState) {
+		t.Fatalf("err = %v, want %v", err, ErrWrongState)
+	}
+}
@@ -0,0 +1,121 @@
+package blackjack
+
+//	----- Spanish 21 -----
+
+/*
+Played from Spanish decks, with the four 10s removed; face cards remain.
+A player 21 always wins, and a winning 21 of five or more cards, or of
+6-7-8 or 7-7-7, pays a bonus unless the hand was doubled.
+After doubling the player may rescue the hand: the double is returned and
+the original bet forfeited.  Late surrender and doubling on any number of
+cards are table rules; see the "spanish-21" preset.
+*/
+
+const (
+	FiveCard21  = "FIVE_CARD_21"
+	SixCard21   = "SIX_CARD_21"
+	SevenCard21 = "SEVEN_CARD_21"
+	Mixed678    = "MIXED_678"
+	Suited678   = "SUITED_678"
+	Spades678   = "SPADES_678"
+	Mixed777    = "MIXED_777"
+	Suited777   = "SUITED_777"
+	Spades777   = "SPADES_777"
+)
+
+// Bonus paid on a winning 21 in place of even money.
+var Spanish21Bonuses = Paytable{
+	FiveCard21:  1.5,
+	SixCard21:   2.0,
+	SevenCard21: 3.0,
+	Mixed678:    1.5,
+	Suited678:   2.0,
+	Spades678:   3.0,
+	Mixed777:    1.5,
+	Suited777:   2.0,
+	Spades777:   3.0,
+}
+
+func init() {
+	RegisterVariant(VariantDefinition{
+		Variant: Spanish21,
+		Deck: func() *Deck {
+			return NewDeck().Without("10")
+		},
+		Legal: func(g *Game, name ActionName, p *Player, h *Hand, err error) error {
+			if name == ActionSurrender && err != nil && canRescue(g, h) {
+				return nil
+			}
+			return err
+		},
+		Outcome: func(g *Game, h *Hand, outcome Outcome) Outcome {
+			if (h.Status == Qualified || h.Status == Blackjack) && h.Value() == 21 {
+				return Win
+			}
+			return outcome
+		},
+		Payout: func(g *Game, h *Hand, stake Stake, outcome Outcome) (int, bool) {
+			// A rescue returns only the double riding the stake.
+			if h.Status == Surrendered && h.DoubleDown {
+				return stake.Doubled, true
+			}
+			if outcome != Win || h.Value() != 21 || h.Status == Blackjack || h.DoubleDown {
+				return 0, false
+			}
+			ratio, ok := Spanish21Bonuses[spanish21Bonus(h.Cards)]
+			if !ok {
+				return 0, false
+			}
+			return int(float64(stake.Amount)*ratio) + stake.Amount, true
+		},
+	})
+}
+
+// A doubled hand still in play may be rescued.
+func canRescue(g *Game, h *Hand) bool {
+	return g.State == StatePlayerTurn && h.DoubleDown && h.Status == Qualified && !h.Stood
+}
+
+// Returns the bonus tier for a 21, or an empty string.
+func spanish21Bonus(cards []Card) string {
+	switch {
+	case len(cards) >= 7:
+		return SevenCard21
+	case len(cards) == 6:
+		return SixCard21
+	case len(cards) == 5:
+		return FiveCard21
+	case len(cards) != 3:
+		return ""
+	}
+
+	var sevens, six, eight int
+	for _, c := range cards {
+		switch c.Rank {
+		case "6":
+			six++
+		case "7":
+			sevens++
+		case "8":
+			eight++
+		}
+	}
+	suited := cards[0].Suit == cards[1].Suit && cards[1].Suit == cards[2].Suit
+	spades := suited && cards[0].Suit == "Spades"
+
+	switch {
+	case sevens == 3 && spades:
+		return Spades777
+	case sevens == 3 && suited:
+		return Suited777
+	case sevens == 3:
+		return Mixed777
+	case six == 1 && sevens == 1 && eight == 1 && spades:
+		return Spades678
+	case six == 1 && sevens == 1 && eight == 1 && suited:
+		return Suited678
+	case six == 1 && sevens == 1 && eight == 1:
+		return Mixed678
+	}
+	return ""
+}
@@ -0,0 +1,98 @@
+package blackjack
+
+import "testing"
+
+func TestSpanish21Deck(t *testing.T) {
+	g, _ := openRound(t, "spanish-21", 10, nil)
+	d := g.newDeck()
+	if len(d.cards) != 48 {
+		t.Fatalf("deck has %d cards, want 48", len(d.cards))
+	}
+	for _, c := range d.cards {
+		if c.Rank == "10" {
+			t.Fatal("Spanish deck holds a 10")
+		}
+	}
+}
+
+func TestSpanish21BonusTier(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []Card
+		want  string
+	}{
+		{"five cards", []Card{card("2"), card("3"), card("2"), card("4"), card("K")}, FiveCard21},
+		{"seven cards", []Card{card("A"), card("2"), card("3"), card("A"), card("2"), card("3"), card("9")}, SevenCard21},
+		{"mixed 678", []Card{suited("6", "Clubs"), suited("7", "Hearts"), suited("8", "Clubs")}, Mixed678},
+		{"suited 678", []Card{suited("8", "Clubs"), suited("6", "Clubs"), suited("7", "Clubs")}, Suited678},
+		{"spades 777", []Card{suited("7", "Spades"), suited("7", "Spades"), suited("7", "Spades")}, Spades777},
+		{"three card 21", []Card{card("4"), card("7"), card("K")}, ""},
+	}
+	for _, tt := range tests {
+		if got := spanish21Bonus(tt.cards); got != tt.want {
+			t.Errorf("%s: tier = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSpanish21Payouts(t *testing.T) {
+	hit := play{0, Hit{}}
+	tests := []struct {
+		name  string
+		ranks []string // Player, dealer up, player, dealer hole, then draws
+		plays []play
+		want  int
+	}{
+		{"five card 21 pays 3:2", []string{"2", "9", "3", "8", "2", "4", "K"}, []play{hit, hit, hit}, 10015},
+		{"suited 678 pays 2:1", []string{"6", "9", "7", "8", "8"}, []play{hit}, 10020},
+		{"doubled 21 pays even money", []string{"6", "9", "7", "8", "8"}, []play{{0, Double{}}}, 10020},
+		{"21 beats a dealer 21", []string{"4", "9", "7", "2", "K", "K"}, []play{hit}, 10010},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := dealRound(t, "spanish-21", 10, tt.ranks)
+			playRound(t, g, p, tt.plays)
+			if p.LocalWallet != tt.want {
+				t.Fatalf("wallet = %d, want %d", p.LocalWallet, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpanish21Rescue(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy BackBetPolicy
+	}{
+		{"double followed", BackBetPolicy{FollowDoubles: true}},
+		{"double not followed", BackBetPolicy{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 6,5 doubles into 14 against a dealer 9/8 and is rescued.
+			g, p := openRound(t, "spanish-21", 10, []string{"6", "9", "5", "8", "3"})
+			bettor := betBehind(t, g, "2", tt.policy)
+			deal(g, p)
+			playRound(t, g, p, []play{{0, Double{}}, {0, Surrender{}}})
+
+			// The double is returned and the original bet forfeited, for the
+			// box player and the back bettor alike.
+			if p.LocalWallet != 9990 {
+				t.Fatalf("wallet = %d, want 9990", p.LocalWallet)
+			}
+			if bettor.LocalWallet != 9990 {
+				t.Fatalf("back bettor wallet = %d, want 9990", bettor.LocalWallet)
+			}
+		})
+	}
+}
+
+func TestSpanish21RescueOnlyAfterDouble(t *testing.T) {
+	g, p := dealRound(t, "spanish-21", 10, []string{"6", "9", "5", "8", "3"})
+	if _, err := ApplyAction(g, p.ID, Hit{}, 0); err != nil {
+		t.Fatal(err)
+	}
+	if LegalActions(g, p.ID, 0).Allowed(ActionSurrender) {
+		t.Fatal("surrender offered after a hit without a double")
+	}
+}
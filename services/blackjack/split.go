@@ -10,25 +10,45 @@ import (
 /*
 The player places an additional bet equal to their original stake.
 Split two cards of matching values, with a single card dealt to each new hand.
-Available only on the first action of a turn.  Players can split up to three times
-for a total of 4 hands. Doubles allowed after splitting.
+Available only on the first action of a turn.  The number of splits, resplitting
+aces and doubling after a split are governed by the table Rules.
+Unless the rules allow hitting split aces, each split ace receives exactly one
+card and stands automatically; a 21 on split aces is not blackjack.
 */
 type Split struct{}
 
-func (Split) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+func (Split) Validate(g *Game, p *Player, h *Hand) error {
 	if g.State != StatePlayerTurn {
-		return false, fmt.Errorf("cannot split while in %s", g.State)
+		return &StateError{Action: "split", State: g.State}
 	}
 
-	canSplit, err := p.CanSplit(h)
+	canSplit, err := p.CanSplit(h, g.Config.Rules)
 	if !canSplit {
+		return err
+	}
+
+	if g.freeStake(ActionSplit, h) {
+		return nil
+	}
+	return checkFunds(p, h.Stake())
+}
+
+func (a Split) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+	if err := g.validate(ActionSplit, a, p, h); err != nil {
 		return false, err
 	}
 
-	splitBetAmount := h.Bet
+	// The new hand carries the same stake, paid by the house on a free split.
+	splitBet, freeBet := h.Stake(), 0
+	free := g.freeStake(ActionSplit, h)
+	if free {
+		splitBet, freeBet = 0, h.Stake()
+		p.FreeBets += freeBet
+	} else if err := g.takeWager(p, splitBet); err != nil {
+		return false, err
+	}
 	c1 := h.Cards[0]
 	c2 := h.Cards[1]
-	p.Wager(splitBetAmount)
 
 	// Active hand becomes just the first card, in a new Cards slice.
 	h.Cards = []Card{c1}
@@ -36,23 +56,32 @@ func (Split) Execute(g *Game, p *Player, h *Hand) (bool, error) {
 	h.DoubleDown = false
 	h.Status = Qualified
 
-	cardForActiveHand := g.Dealer.Shoe.Draw()
+	cardForActiveHand := g.draw()
 	h.Cards = append(h.Cards, cardForActiveHand)
+	g.recordDealt(h, cardForActiveHand)
 
 	// New hand starts with the second card and a turn is injected into the turn queue.
-	cardForSplitHand := g.Dealer.Shoe.Draw()
-	splitHand := NewHand(splitBetAmount, SplitConfig{
+	cardForSplitHand := g.draw()
+	splitHand := NewHand(splitBet, SplitConfig{
 		Index:   HandIndex(len(p.Hands)),
+		Seat:    h.Seat,
 		Cards:   []Card{c2, cardForSplitHand},
 		IsSplit: true,
 	})
+	splitHand.FreeBet = freeBet
 
-	// Append the new hand and inject its turn to be played next.
-	p.AddHand(splitHand)
-	g.InjectNext(Turn{
-		Player: p,
-		Hand:   splitHand,
-	})
+	// Append the new hand and inject its turn to be played next.  Shared-hand
+	// tables have no turn queue; the hand acts at the next decision point.
+	if err := p.AddHand(splitHand); err != nil {
+		return false, err
+	}
+	g.followSplit(h, splitHand)
+	if !g.variant().SharedHand {
+		g.InjectNext(Turn{
+			Player: p,
+			Hand:   splitHand,
+		})
+	}
 
 	g.Store.Append(store.Event{
 		Type: "Split",
@@ -60,8 +89,33 @@ func (Split) Execute(g *Game, p *Player, h *Hand) (bool, error) {
 			"PlayerID":   p.ID,
 			"ActiveHand": fmt.Sprintf("%v", h.Cards),
 			"SplitHand":  fmt.Sprintf("%v", splitHand.Cards),
+			"Free":       free,
 		},
 	})
 
-	return false, nil
+	g.autoStandSplitAces(p, splitHand)
+	return g.autoStandSplitAces(p, h), nil
+}
+
+// Stands a split ace that has taken its one card, unless it may still be resplit.
+// Returns true if the hand was stood.
+func (g *Game) autoStandSplitAces(p *Player, h *Hand) bool {
+	rules := g.Config.Rules
+	if !h.IsSplitAces() || rules.HitSplitAces {
+		return false
+	}
+	if rules.ResplitAces && h.Cards[1].Rank == "A" && p.HandsInSeat(h.Seat) < rules.MaxHands() {
+		return false
+	}
+
+	h.Stood = true
+	g.Store.Append(store.Event{
+		Type: "AutoStand",
+		Payload: map[string]any{
+			"PlayerID":  p.ID,
+			"HandIndex": h.Index,
+			"Hand":      h.Cards,
+		},
+	})
+	return true
 }
@@ -0,0 +1,56 @@
+package blackjack
+
+import "testing"
+
+// One card to each split ace.
+func oneCardSplitAces(c *GameConfig) {
+	c.Rules.HitSplitAces = false
+	c.Rules.ResplitAces = false
+}
+
+func TestSplitAcesStand(t *testing.T) {
+	g, p := dealRound(t, DefaultPreset, 10, []string{"A", "9", "A", "K", "K", "Q"}, oneCardSplitAces)
+	endTurn, err := (Split{}).Execute(g, p, p.Hands[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !endTurn {
+		t.Fatal("turn left open on split aces")
+	}
+	for _, h := range p.Hands {
+		if !h.Stood || len(h.Cards) != 2 {
+			t.Fatalf("hand %d: %v stood %v, want one card and stood", h.Index, h.Cards, h.Stood)
+		}
+		if _, err := (Hit{}).Execute(g, p, h); err == nil {
+			t.Fatalf("hand %d: hit a split ace", h.Index)
+		}
+	}
+
+	// Each 21 pays even money, not the blackjack payout.
+	g.AdvanceTurn()
+	g.State = StateDealerTurn
+	g.DealerTurn()
+	g.Settle()
+	if p.LocalWallet != 10020 {
+		t.Fatalf("wallet = %d, want 10020", p.LocalWallet)
+	}
+}
+
+func TestSplitAcesResplit(t *testing.T) {
+	resplit := func(c *GameConfig) {
+		c.Rules.HitSplitAces = false
+		c.Rules.ResplitAces = true
+		c.Rules.MaxSplits = 3
+	}
+	g, p := dealRound(t, DefaultPreset, 10, []string{"A", "9", "A", "K", "A", "Q"}, resplit)
+	if _, err := (Split{}).Execute(g, p, p.Hands[0]); err != nil {
+		t.Fatal(err)
+	}
+	// The first ace drew another ace and may be split again.
+	if p.Hands[0].Stood {
+		t.Fatal("resplittable ace was stood")
+	}
+	if !p.Hands[1].Stood {
+		t.Fatal("split ace on a queen was not stood")
+	}
+}
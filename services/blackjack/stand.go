@@ -10,15 +10,32 @@ import (
 // Player may stand to end the turn with a qualifying hand.
 type Stand struct{}
 
-func (Stand) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+func (Stand) Validate(g *Game, p *Player, h *Hand) error {
 	if g.State != StatePlayerTurn {
-		return false, fmt.Errorf("cannot stand while in %s", g.State)
+		return &StateError{Action: "stand", State: g.State}
 	}
 
 	if h.Status == Busted {
-		return false, fmt.Errorf("stand not applicable; player busted")
+		return fmt.Errorf("%w: player busted", ErrHandNotInPlay)
 	}
 
+	if h.Status != Qualified {
+		return ErrHandNotInPlay
+	}
+
+	if h.Stood {
+		return fmt.Errorf("%w: hand already stands", ErrHandNotInPlay)
+	}
+	return nil
+}
+
+func (a Stand) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+	if err := g.validate(ActionStand, a, p, h); err != nil {
+		return false, err
+	}
+
+	h.Stood = true
+
 	e := store.Event{
 		Type: "Stand",
 		Payload: map[string]any{
@@ -18,6 +18,8 @@ const (
 	StateBetsOpen      fsm.State = "BetsOpen"
 	StateBetsClosed    fsm.State = "BetsClosed"
 	StateBetsSettle    fsm.State = "BetsSettle"
+	StateSwitchTurn    fsm.State = "SwitchTurn"
+	StateSurrenderTurn fsm.State = "SurrenderTurn"
 	StateInsuranceTurn fsm.State = "InsuranceTurn"
 	StatePlayerTurn    fsm.State = "PlayerTurn"
 	StateDealerTurn    fsm.State = "DealerTurn"
@@ -2,34 +2,107 @@ package blackjack
 
 import (
 	// Standard libs
+	"bufio"
 	"fmt"
+	"os"
 	// Internal
 	"casino/libs/store"
 )
 
-// The player may surrender their hand, to recover half their original bet, and end their turn.
-// Available only on the first action of a turn.
+/*
+The player may surrender their hand, to recover half their original bet, and end their turn.
+Available only on the first action of a turn and when the table offers surrender.
+Early surrender is taken in the surrender phase before the dealer peeks; late surrender
+on the first action of the player's turn.  Split hands may only be surrendered when the
+rules allow it.
+*/
 type Surrender struct{}
 
-func (Surrender) Execute(g *Game, p *Player, h *Hand) (bool, error) {
-	if g.State != StatePlayerTurn {
-		return false, fmt.Errorf("cannot surrender while in %s", g.State)
+func (Surrender) Validate(g *Game, p *Player, h *Hand) error {
+	rules := g.Config.Rules
+	switch g.State {
+	case StateSurrenderTurn:
+		up, _ := g.Dealer.UpCard()
+		if !rules.EarlySurrenderAgainst(up) {
+			return fmt.Errorf("%w: early surrender against %s", ErrNotOffered, up)
+		}
+	case StatePlayerTurn:
+		if rules.Surrender == SurrenderNone {
+			return fmt.Errorf("%w: surrender", ErrNotOffered)
+		}
+	default:
+		return &StateError{Action: "surrender", State: g.State}
 	}
 
 	if !h.IsFirstAction() {
-		return false, fmt.Errorf("can only surrender on first action")
+		return fmt.Errorf("%w: surrender", ErrNotFirstAction)
 	}
 
+	if h.IsSplit && !rules.SurrenderSplits {
+		return fmt.Errorf("%w: cannot surrender after split", ErrNotAllowed)
+	}
+	return nil
+}
+
+func (a Surrender) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+	if err := g.validate(ActionSurrender, a, p, h); err != nil {
+		return false, err
+	}
+
+	taken := SurrenderLate
+	if g.State == StateSurrenderTurn {
+		taken = SurrenderEarly
+	}
 	h.Surrender()
+	h.SurrenderedAs = taken
 
+	eventType := "LateSurrender"
+	if taken == SurrenderEarly {
+		eventType = "EarlySurrender"
+	}
 	e := store.Event{
-		Type: "Surrender",
+		Type: eventType,
 		Payload: map[string]any{
 			"PlayerID":    p.ID,
+			"HandIndex":   h.Index,
 			"Surrendered": true,
+			"Rescue":      h.DoubleDown,
+			"Bet":         h.Bet,
 		},
 	}
 
 	g.Store.Append(e)
 	return true, nil
 }
+
+// Prompts players for early surrender before the dealer peeks.
+func (g *Game) OfferEarlySurrender() {
+	if g.State != StateSurrenderTurn {
+		return
+	}
+	fmt.Println("Early surrender open.")
+
+	scanner := bufio.NewScanner(os.Stdin)
+	g.DoForEachBoxInPlay(func(b *Box) {
+		p, h := b.Player, b.Hand
+		if !h.IsFirstAction() {
+			return
+		}
+		fmt.Printf("\n%s, Surrender? (y/n): ", p.Name)
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				fmt.Printf("input error while reading surrender prompt for %s: %v", p.Name, err)
+			} else {
+				fmt.Printf("no more input (EOF) while reading surrender prompt for %s", p.Name)
+			}
+			return
+		}
+		if scanner.Text() != "y" {
+			return
+		}
+		if _, err := ApplyAction(g, p.ID, Surrender{}, h.Index); err != nil {
+			fmt.Println("error:", err)
+		}
+	})
+	fmt.Println("Early surrender closed.")
+}
@@ -0,0 +1,81 @@
+package blackjack
+
+import "testing"
+
+func TestEarlySurrenderAgainst(t *testing.T) {
+	tests := []struct {
+		mode SurrenderMode
+		up   string
+		want bool
+	}{
+		{SurrenderLate, "A", false},
+		{SurrenderEarly, "A", true},
+		{SurrenderEarly, "K", true},
+		{SurrenderEarly, "9", false},
+		{SurrenderEarlyTens, "A", false},
+		{SurrenderEarlyTens, "10", true},
+	}
+	for _, tt := range tests {
+		r := Rules{Surrender: tt.mode}
+		if got := r.EarlySurrenderAgainst(card(tt.up)); got != tt.want {
+			t.Errorf("%s against %s = %v, want %v", tt.mode, tt.up, got, tt.want)
+		}
+	}
+}
+
+func TestLateSurrender(t *testing.T) {
+	late := func(c *GameConfig) { c.Rules.Surrender = SurrenderLate }
+	tests := []struct {
+		name   string
+		preset string
+		ranks  []string
+		want   int
+	}{
+		{"half returned", DefaultPreset, []string{"10", "9", "6", "7", "10"}, 9995},
+		// Without a hole card the dealer's blackjack still beats a late surrender.
+		{"dealer blackjack at no-hole-card table", "european", []string{"10", "K", "6", "A"}, 9990},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := dealRound(t, tt.preset, 10, tt.ranks, late)
+			playRound(t, g, p, []play{{0, Surrender{}}})
+			if p.LocalWallet != tt.want {
+				t.Fatalf("wallet = %d, want %d", p.LocalWallet, tt.want)
+			}
+		})
+	}
+}
+
+func TestEarlySurrender(t *testing.T) {
+	g, p := dealRound(t, DefaultPreset, 10, []string{"10", "9", "6", "7"})
+	h := p.Hands[0]
+	// Surrender before the peek against a dealer blackjack.
+	g.Config.Rules.Surrender = SurrenderEarly
+	g.Dealer.Hand.Cards = []Card{card("K"), card("A")}
+	g.State = StateSurrenderTurn
+	if _, err := (Surrender{}).Execute(g, p, h); err != nil {
+		t.Fatal(err)
+	}
+	if h.SurrenderedAs != SurrenderEarly {
+		t.Fatalf("surrendered as %s, want %s", h.SurrenderedAs, SurrenderEarly)
+	}
+	g.Dealer.Hand.Status = Blackjack
+	g.State = StateBetsSettle
+	g.Settle()
+	if p.LocalWallet != 9995 {
+		t.Fatalf("wallet = %d, want 9995", p.LocalWallet)
+	}
+}
+
+func TestSurrenderSplits(t *testing.T) {
+	for _, allowed := range []bool{true, false} {
+		g, p := dealRound(t, DefaultPreset, 10, []string{"10", "9", "6", "7"})
+		g.Config.Rules.SurrenderSplits = allowed
+		h := p.Hands[0]
+		h.IsSplit = true
+		_, err := (Surrender{}).Execute(g, p, h)
+		if (err == nil) != allowed {
+			t.Errorf("SurrenderSplits %v: err = %v", allowed, err)
+		}
+	}
+}
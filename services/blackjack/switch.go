@@ -0,0 +1,128 @@
+package blackjack
+
+import (
+	// Standard libs
+	"bufio"
+	"fmt"
+	"os"
+	// Internal
+	"casino/libs/store"
+)
+
+//	----- Blackjack Switch -----
+
+/*
+Each player plays two boxes with equal wagers; a player whose boxes do not
+both carry the same wager has their wagers returned and is not dealt in.
+Once the cards are dealt the player may swap the second cards between the
+boxes.  A two-card 21 made by switching counts as 21, not blackjack.
+Blackjack pays even money and a dealer 22 pushes every hand except a
+blackjack.
+*/
+
+func init() {
+	RegisterVariant(VariantDefinition{
+		Variant:        BlackjackSwitch,
+		BoxesPerPlayer: 2,
+		SwitchCards:    true,
+		Outcome:        dealer22Pushes,
+		Payout:         blackjackPaysEvenMoney,
+	})
+}
+
+// Swaps the second cards of the player's two boxes.  Either hand may be named.
+type Switch struct{}
+
+func (Switch) Validate(g *Game, p *Player, h *Hand) error {
+	if g.State != StateSwitchTurn {
+		return &StateError{Action: "switch", State: g.State}
+	}
+	if !g.variant().SwitchCards {
+		return fmt.Errorf("%w: switch", ErrNotOffered)
+	}
+	first, second, err := g.switchPair(p)
+	if err != nil {
+		return err
+	}
+	if h != first && h != second {
+		return fmt.Errorf("%w: hand %d is not dealt to a box", ErrNotAllowed, h.Index)
+	}
+	if first.Switched {
+		return fmt.Errorf("%w: cards already switched", ErrNotAllowed)
+	}
+	return nil
+}
+
+func (a Switch) Execute(g *Game, p *Player, h *Hand) (bool, error) {
+	if err := g.validate(ActionSwitch, a, p, h); err != nil {
+		return false, err
+	}
+
+	first, second, _ := g.switchPair(p)
+	first.Cards[1], second.Cards[1] = second.Cards[1], first.Cards[1]
+	first.Switched = true
+	second.Switched = true
+
+	g.Store.Append(store.Event{
+		Type: "Switch",
+		Payload: map[string]any{
+			"PlayerID":   p.ID,
+			"FirstHand":  fmt.Sprintf("%v", first.Cards),
+			"SecondHand": fmt.Sprintf("%v", second.Cards),
+		},
+	})
+	return false, nil
+}
+
+// Returns the initial hands of the player's two boxes, both of which must be
+// dealt in on a wager.
+func (g *Game) switchPair(p *Player) (*Hand, *Hand, error) {
+	boxes := g.BoxesFor(p)
+	if len(boxes) != 2 {
+		return nil, nil, fmt.Errorf("%w: switching needs exactly two boxes in play", ErrNotAllowed)
+	}
+	for _, b := range boxes {
+		if b.Hand == nil || b.Bet == 0 {
+			return nil, nil, fmt.Errorf("%w: seat %d has no wager in play", ErrNotAllowed, b.Seat)
+		}
+	}
+	first, second := boxes[0].Hand, boxes[1].Hand
+	if len(first.Cards) != 2 || len(second.Cards) != 2 {
+		return nil, nil, fmt.Errorf("%w: switch", ErrNotFirstAction)
+	}
+	return first, second, nil
+}
+
+// Prompts each player holding two boxes whether to switch their second cards.
+func (g *Game) OfferSwitch() {
+	if g.State != StateSwitchTurn {
+		return
+	}
+	fmt.Println("Switch open.")
+
+	scanner := bufio.NewScanner(os.Stdin)
+	g.DoForEachPlayer(func(p *Player) {
+		first, second, err := g.switchPair(p)
+		if err != nil {
+			return
+		}
+		PrintPlayerHand(p, first)
+		PrintPlayerHand(p, second)
+		fmt.Printf("\n%s, Switch second cards? (y/n): ", p.Name)
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				fmt.Printf("input error while reading switch prompt for %s: %v", p.Name, err)
+			} else {
+				fmt.Printf("no more input (EOF) while reading switch prompt for %s", p.Name)
+			}
+			return
+		}
+		if scanner.Text() != "y" {
+			return
+		}
+		if _, err := ApplyAction(g, p.ID, Switch{}, first.Index); err != nil {
+			fmt.Println("error:", err)
+		}
+	})
+	fmt.Println("Switch closed.")
+}
@@ -0,0 +1,137 @@
+package blackjack
+
+import (
+	// Standard libs
+	"errors"
+	"os"
+	"testing"
+	// Internal
+	"casino/libs/store"
+)
+
+// Opens a Blackjack Switch table with one player across two boxes, stacks
+// the shoe and places the given wager on each box.  Betting is still open.
+func openSwitch(t *testing.T, bets [2]int, ranks []string) (*Game, *Player) {
+	t.Helper()
+	g, err := NewGameFromPreset(store.NewEventStore(), "blackjack-switch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewPlayer("1", "Tester")
+	if _, err := g.JoinTable(p); err != nil {
+		t.Fatal(err)
+	}
+	g.Dealer.Shoe = stackedShoe(ranks...)
+	g.State = StateBetsOpen
+	for i, b := range g.BoxesFor(p) {
+		if bets[i] == 0 {
+			continue
+		}
+		if err := g.PlaceBet(b.Seat, bets[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return g, p
+}
+
+// Feeds the input to the table's prompts for the rest of the test.
+func withInput(t *testing.T, input string) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.WriteString(input); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+	stdin := os.Stdin
+	os.Stdin = r
+	t.Cleanup(func() {
+		os.Stdin = stdin
+		r.Close()
+	})
+}
+
+// Deals both boxes, answering the switch prompt, and queues the hands.
+func dealSwitch(t *testing.T, g *Game, switchCards bool) {
+	t.Helper()
+	if switchCards {
+		withInput(t, "y\n")
+	} else {
+		withInput(t, "n\n")
+	}
+	g.State = StateBetsClosed
+	g.DealCards()
+	if g.State == StatePlayerTurn {
+		g.DoForEachBoxInPlay(func(b *Box) {
+			g.Enqueue(Turn{Player: b.Player, Hand: b.Hand})
+		})
+	}
+}
+
+func TestSwitchPayouts(t *testing.T) {
+	stand := []play{{0, Stand{}}, {1, Stand{}}}
+	tests := []struct {
+		name     string
+		ranks    []string // Box one, box two, dealer up, box one, box two, dealer hole, then draws
+		switched bool
+		want     int
+	}{
+		// 10,6 and 5,K become 10,K and 5,6; 20 beats 17 and 11 stands to lose.
+		{"switched", []string{"10", "5", "9", "6", "K", "8"}, true, 10000},
+		// A,6 and 9,K become A,K and 9,6 against a dealer 22; the switched
+		// A,K is a 21, not blackjack, and pushes.
+		{"switched 21 is not blackjack", []string{"A", "9", "6", "6", "K", "6", "K"}, true, 10000},
+		{"blackjack pays even money", []string{"A", "9", "9", "K", "8", "8"}, false, 10010},
+		// The dealer's 6,6 draws a K: 20 pushes and blackjack still wins.
+		{"dealer 22 pushes", []string{"A", "10", "6", "K", "K", "6", "K"}, false, 10010},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, p := openSwitch(t, [2]int{10, 10}, tt.ranks)
+			dealSwitch(t, g, tt.switched)
+			var plays []play
+			for _, pl := range stand {
+				if p.Hands[pl.hand].Status == Qualified {
+					plays = append(plays, pl)
+				}
+			}
+			playRound(t, g, p, plays)
+			if p.LocalWallet != tt.want {
+				t.Fatalf("wallet = %d, want %d", p.LocalWallet, tt.want)
+			}
+		})
+	}
+}
+
+func TestSwitchWagersMatch(t *testing.T) {
+	g, p := openSwitch(t, [2]int{10, 0}, []string{"9", "8"})
+	var amountErr *AmountError
+	if err := g.PlaceBet(g.BoxesFor(p)[1].Seat, 20); !errors.As(err, &amountErr) {
+		t.Fatalf("err = %v, want an AmountError", err)
+	}
+
+	// With one box unwagered neither box is dealt and the wager is returned.
+	g.State = StateBetsClosed
+	g.DealCards()
+	if len(p.Hands) != 0 {
+		t.Fatalf("%d hands dealt, want none", len(p.Hands))
+	}
+	if p.LocalWallet != 10000 || p.TotalBet != 0 {
+		t.Fatalf("wallet %d and total bet %d, want 10000 and 0", p.LocalWallet, p.TotalBet)
+	}
+}
+
+func TestSwitchNeedsWagers(t *testing.T) {
+	g, p := openSwitch(t, [2]int{10, 10}, []string{"10", "5", "9", "6", "K", "8"})
+	dealSwitch(t, g, false)
+	if p.Hands[0].Cards[1] != card("6") {
+		t.Fatalf("cards switched when declined: %v", p.Hands[0].Cards)
+	}
+	g.State = StateSwitchTurn
+	g.BoxesFor(p)[1].Bet = 0
+	if _, err := ApplyAction(g, p.ID, Switch{}, 0); !errors.Is(err, ErrNotAllowed) {
+		t.Fatalf("err = %v, want %v", err, ErrNotAllowed)
+	}
+}
@@ -0,0 +1,97 @@
+package blackjack
+
+import "sort"
+
+//	----- 21+3 -----
+
+/*
+Pays on the player's first two cards and the dealer up card as a
+three-card poker hand.  Placed while bets are open and resolved right
+after the deal, before the dealer peeks.
+*/
+
+const (
+	Flush         = "FLUSH"
+	Straight      = "STRAIGHT"
+	ThreeOfAKind  = "THREE_OF_A_KIND"
+	StraightFlush = "STRAIGHT_FLUSH"
+	SuitedTrips   = "SUITED_TRIPS"
+)
+
+func init() {
+	RegisterSideBet(SideBetDefinition{
+		Type:    TwentyOne3Bet,
+		Window:  StateBetsOpen,
+		Trigger: ResolveAfterDeal,
+		Paytable: func(cfg *GameConfig) Paytable {
+			return Paytable{
+				Flush:         5,
+				Straight:      10,
+				ThreeOfAKind:  30,
+				StraightFlush: 40,
+				SuitedTrips:   100,
+			}
+		},
+		Resolve: func(g *Game, p *Player, h *Hand) string {
+			up, ok := g.Dealer.UpCard()
+			if !ok || len(h.Cards) < 2 {
+				return ""
+			}
+			return threeCardPokerTier(h.Cards[0], h.Cards[1], up)
+		},
+	})
+}
+
+// Returns the best 21+3 tier for three cards, or an empty string.
+func threeCardPokerTier(cards ...Card) string {
+	flush := cards[0].Suit == cards[1].Suit && cards[1].Suit == cards[2].Suit
+	trips := cards[0].Rank == cards[1].Rank && cards[1].Rank == cards[2].Rank
+	straight := isStraight(cards)
+
+	switch {
+	case trips && flush:
+		return SuitedTrips
+	case straight && flush:
+		return StraightFlush
+	case trips:
+		return ThreeOfAKind
+	case straight:
+		return Straight
+	case flush:
+		return Flush
+	default:
+		return ""
+	}
+}
+
+// Returns true if the cards run in sequence; aces play high or low.
+func isStraight(cards []Card) bool {
+	order := map[string]int{
+		"A": 1, "2": 2, "3": 3, "4": 4, "5": 5, "6": 6, "7": 7,
+		"8": 8, "9": 9, "10": 10, "J": 11, "Q": 12, "K": 13,
+	}
+	ranks := make([]int, 0, len(cards))
+	for _, c := range cards {
+		ranks = append(ranks, order[c.Rank])
+	}
+	sort.Ints(ranks)
+
+	if consecutive(ranks) {
+		return true
+	}
+	if ranks[0] == 1 {
+		// Ace high: Q-K-A
+		high := append([]int{}, ranks[1:]...)
+		return consecutive(append(high, 14))
+	}
+	return false
+}
+
+func consecutive(ranks []int) bool {
+	for i := 1; i < len(ranks); i++ {
+		if ranks[i] != ranks[i-1]+1 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package blackjack
+
+import "testing"
+
+func TestThreeCardPokerTier(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []Card
+		want  string
+	}{
+		{"nothing", []Card{suited("2", "Hearts"), suited("9", "Spades"), suited("K", "Clubs")}, ""},
+		{"flush", []Card{suited("2", "Hearts"), suited("9", "Hearts"), suited("K", "Hearts")}, Flush},
+		{"straight", []Card{suited("9", "Hearts"), suited("10", "Spades"), suited("J", "Clubs")}, Straight},
+		{"ace low straight", []Card{suited("A", "Hearts"), suited("2", "Spades"), suited("3", "Clubs")}, Straight},
+		{"ace high straight", []Card{suited("Q", "Hearts"), suited("K", "Spades"), suited("A", "Clubs")}, Straight},
+		{"no wraparound", []Card{suited("K", "Hearts"), suited("A", "Spades"), suited("2", "Clubs")}, ""},
+		{"three of a kind", []Card{suited("7", "Hearts"), suited("7", "Spades"), suited("7", "Clubs")}, ThreeOfAKind},
+		{"straight flush", []Card{suited("4", "Clubs"), suited("5", "Clubs"), suited("6", "Clubs")}, StraightFlush},
+		{"suited trips", []Card{suited("7", "Clubs"), suited("7", "Clubs"), suited("7", "Clubs")}, SuitedTrips},
+	}
+	for _, tt := range tests {
+		if got := threeCardPokerTier(tt.cards...); got != tt.want {
+			t.Errorf("%s: tier = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTwentyOnePlus3Pays(t *testing.T) {
+	offer := func(c *GameConfig) {
+		c.SideBets = map[SideBetType]Paytable{TwentyOne3Bet: nil}
+	}
+	// The player's 8,10 and the dealer's 9 up make a straight flush in hearts.
+	g, p := openRound(t, DefaultPreset, 10, []string{"8", "9", "10", "7"}, offer)
+	if _, err := (PlaceSideBet{Type: TwentyOne3Bet, Amount: 5}).Execute(g, p, nil); err != nil {
+		t.Fatal(err)
+	}
+	deal(g, p)
+	if want := 9985 + 5*40 + 5; p.LocalWallet != want {
+		t.Fatalf("wallet = %d, want %d", p.LocalWallet, want)
+	}
+}
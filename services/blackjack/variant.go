@@ -0,0 +1,112 @@
+package blackjack
+
+//	----- Game Variants -----
+
+/*
+A variant changes the game itself rather than the house rules: the cards in
+the shoe, which moves are legal and how hands are paid.  Each variant
+registers optional hooks and the engine consults them at the matching
+points, so the actions, shoe and settlement loop are shared by every game.
+A table with no variant plays standard blackjack.
+*/
+
+type Variant string
+
+const (
+	StandardBlackjack Variant = "STANDARD"
+	Spanish21         Variant = "SPANISH_21"
+	BlackjackSwitch   Variant = "SWITCH"
+	DoubleExposure    Variant = "DOUBLE_EXPOSURE"
+	FreeBetBlackjack  Variant = "FREE_BET"
+	InfiniteBlackjack Variant = "INFINITE"
+)
+
+type VariantDefinition struct {
+	Variant Variant
+	// Boxes a player takes on joining the table; zero or one seats a single box.
+	// Each of a player's boxes carries the same wager.
+	BoxesPerPlayer int
+	// Players may swap second cards between their boxes before play.
+	SwitchCards bool
+	// Both dealer cards are dealt face up, so there is no peek and no insurance.
+	DealerExposed bool
+	// Every box is dealt the same cards and plays at shared decision points
+	// rather than from the turn queue; seats are unlimited.
+	SharedHand bool
+	// Optional; builds each deck of the shoe in place of NewDeck.
+	Deck func() *Deck
+	// Optional; given the standard verdict on a player action, returns the
+	// variant's verdict.  Returning nil permits the action.
+	Legal func(g *Game, name ActionName, p *Player, h *Hand, err error) error
+	// Optional; returns true when the house funds the stake added to the hand
+	// by a double or split.
+	FreeStake func(g *Game, name ActionName, h *Hand) bool
+	// Optional; given the standard outcome of a hand, returns the variant's outcome.
+	Outcome func(g *Game, h *Hand, outcome Outcome) Outcome
+	// Optional; returns the amount paid on a stake, stake included, and true
+	// to replace the standard payout.
+	Payout func(g *Game, h *Hand, stake Stake, outcome Outcome) (int, bool)
+}
+
+var variants = map[Variant]VariantDefinition{
+	StandardBlackjack: {Variant: StandardBlackjack},
+}
+
+// Adds or replaces a variant definition.
+func RegisterVariant(def VariantDefinition) {
+	variants[def.Variant] = def
+}
+
+// Returns the variant definition for a name.
+func LookupVariant(v Variant) (VariantDefinition, bool) {
+	def, ok := variants[v]
+	return def, ok
+}
+
+// Returns the definition of the variant played at the table.
+// An unset or unknown variant plays standard blackjack.
+func (g *Game) variant() VariantDefinition {
+	if def, ok := LookupVariant(g.Config.Variant); ok {
+		return def
+	}
+	return variants[StandardBlackjack]
+}
+
+// Builds one deck for the shoe.
+func (g *Game) newDeck() *Deck {
+	if def := g.variant(); def.Deck != nil {
+		return def.Deck()
+	}
+	return NewDeck()
+}
+
+// Returns true if the house funds the stake a double or split adds to the hand.
+func (g *Game) freeStake(name ActionName, h *Hand) bool {
+	def := g.variant()
+	return def.FreeStake != nil && def.FreeStake(g, name, h)
+}
+
+// Checks a player action against the table rules and then the variant.
+func (g *Game) validate(name ActionName, v Validator, p *Player, h *Hand) error {
+	err := v.Validate(g, p, h)
+	if def := g.variant(); def.Legal != nil {
+		return def.Legal(g, name, p, h, err)
+	}
+	return err
+}
+
+// A dealer 22 pushes winning hands other than blackjack.
+func dealer22Pushes(g *Game, h *Hand, outcome Outcome) Outcome {
+	if outcome == Win && h.Status != Blackjack && g.Dealer.Hand.Value() == 22 {
+		return Push
+	}
+	return outcome
+}
+
+// Pays a winning blackjack at even money, whatever the table's BlackjackPayout.
+func blackjackPaysEvenMoney(g *Game, h *Hand, stake Stake, outcome Outcome) (int, bool) {
+	if outcome == Win && h.Status == Blackjack {
+		return stake.Amount + stake.Amount, true
+	}
+	return 0, false
+}